@@ -1,21 +1,30 @@
 package tableau
 
 import (
+	"context"
 	"fmt"
 	"github.com/tiketdatarisal/tableau/models"
 	"net/http"
+	"sync"
 	"time"
 )
 
 type authentication struct {
-	base        *Client
-	signInAt    *time.Time
-	accessToken string
-	userID      string
-	siteID      string
+	base              *Client
+	mu                sync.RWMutex
+	signInMu          sync.Mutex
+	signInAt          *time.Time
+	lifetime          time.Duration
+	accessToken       string
+	userID            string
+	siteID            string
+	impersonateUserID string
 }
 
 func (a *authentication) getBearerToken() string {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
 	if a.accessToken == "" {
 		return ""
 	}
@@ -23,12 +32,54 @@ func (a *authentication) getBearerToken() string {
 	return fmt.Sprintf(bearerAuthorization, a.accessToken)
 }
 
+func (a *authentication) getUserID() string {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	return a.userID
+}
+
+func (a *authentication) getSiteID() string {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	return a.siteID
+}
+
+// setSession replace current session with the credentials returned by sign in or switch site.
+func (a *authentication) setSession(cred *models.Credentials) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if cred == nil {
+		a.signInAt = nil
+		a.accessToken = ""
+		a.userID = ""
+		a.siteID = ""
+		return
+	}
+
+	ts := time.Now()
+	a.signInAt = &ts
+	a.lifetime = tokenLifetime
+	if d := cred.GetEstimatedTimeToExpiration(); d > 0 {
+		a.lifetime = d
+	}
+
+	a.accessToken = cred.Token
+	a.userID = *cred.User.ID
+	a.siteID = *cred.Site.ID
+}
+
 func (a *authentication) IsSignedIn() bool {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
 	if a.userID == "" || a.accessToken == "" || a.siteID == "" || a.signInAt == nil {
 		return false
 	}
 
-	if time.Now().Sub(*a.signInAt) >= tokenLifetime {
+	if time.Now().Sub(*a.signInAt) >= a.lifetime {
 		return false
 	}
 
@@ -37,7 +88,11 @@ func (a *authentication) IsSignedIn() bool {
 
 // SignIn Signs you in as a user on the specified site on Tableau Server or Tableau Online.
 // This call returns a credentials token that you use in subsequent calls to the server.
-// Typically, a credentials token is valid for 120 minutes.
+// Typically, a credentials token is valid for 120 minutes, unless the server returns its estimated time to expiration.
+// When connected app is configured, a signed JSON Web Token is used to sign in as the connected app username.
+// Otherwise, when personal access token name and secret are configured, they are used instead of username and password.
+// When impersonated user ID is set by ImpersonateUser, you are signed in as that user.
+// Connected app requires REST API version 3.14 or later, and personal access token requires version 3.6 or later.
 //
 // URI:
 //
@@ -45,49 +100,88 @@ func (a *authentication) IsSignedIn() bool {
 //
 // Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_authentication.htm#sign_in
 func (a *authentication) SignIn(force ...bool) error {
+	return a.SignInContext(context.Background(), force...)
+}
+
+// SignInContext Same as SignIn, using ctx to cancel the request.
+func (a *authentication) SignInContext(ctx context.Context, force ...bool) error {
+	a.signInMu.Lock()
+	defer a.signInMu.Unlock()
+
 	forceSignIn := len(force) > 0 && force[0]
 	if a.IsSignedIn() && !forceSignIn {
 		return nil
 	}
 
+	return a.signIn(ctx)
+}
+
+// refreshSession signs you in again, unless the session was already refreshed after bearerToken was used.
+func (a *authentication) refreshSession(ctx context.Context, bearerToken string) error {
+	a.signInMu.Lock()
+	defer a.signInMu.Unlock()
+
+	if a.IsSignedIn() && a.getBearerToken() != bearerToken {
+		return nil
+	}
+
+	return a.signIn(ctx)
+}
+
+// signIn sends sign in request. The caller must hold signInMu.
+func (a *authentication) signIn(ctx context.Context) error {
 	reqBody := models.SignInBody{
 		Credentials: &models.Credentials{
-			Name:     a.base.cfg.Username,
-			Password: a.base.cfg.Password,
 			Site: &models.Site{
 				ContentUrl: &a.base.cfg.ContentUrl,
 			},
 		},
 	}
 
-	url := a.base.cfg.GetUrl(signInUri)
-	if url == "" {
-		return ErrInvalidHost
+	if a.impersonateUserID != "" {
+		reqBody.Credentials.User = &models.User{
+			ID: &a.impersonateUserID,
+		}
 	}
 
-	res, err := a.base.c.R().
-		SetHeader(contentTypeHeader, mimeTypeJSON).
-		SetHeader(acceptHeader, mimeTypeJSON).
-		SetBody(reqBody).
-		Post(url)
+	switch {
+	case a.base.cfg.ConnectedApp != nil:
+		if err := a.base.requireVersion("SignIn using connected app", minVersionConnectedApp); err != nil {
+			return err
+		}
 
-	a.base.SetResponse(*res)
-	if err != nil {
-		errBody, err := models.NewErrorBody(res.Body())
+		token, err := a.base.cfg.ConnectedApp.newToken()
 		if err != nil {
-			return ErrUnknownError
+			return err
 		}
 
-		return errBody.Error
+		reqBody.Credentials.JWT = token
+	case a.base.cfg.usePersonalAccessToken():
+		if err := a.base.requireVersion("SignIn using personal access token", minVersionPersonalAccessToken); err != nil {
+			return err
+		}
+
+		reqBody.Credentials.PersonalAccessTokenName = a.base.cfg.PersonalAccessTokenName
+		reqBody.Credentials.PersonalAccessTokenSecret = a.base.cfg.PersonalAccessTokenSecret
+	default:
+		reqBody.Credentials.Name = a.base.cfg.Username
+		reqBody.Credentials.Password = a.base.cfg.Password
 	}
 
-	if res.StatusCode() != http.StatusOK {
-		errBody, err := models.NewErrorBody(res.Body())
-		if err != nil {
-			return ErrUnknownError
-		}
+	url := a.base.cfg.GetUrl(signInUri)
+	if url == "" {
+		return ErrInvalidHost
+	}
+
+	req := a.base.c.R().
+		SetContext(ctx).
+		SetHeader(contentTypeHeader, mimeTypeJSON).
+		SetHeader(acceptHeader, mimeTypeJSON).
+		SetBody(reqBody)
 
-		return errBody.Error
+	res, err := a.base.executeOnce(req, http.MethodPost, url, http.StatusOK)
+	if err != nil {
+		return err
 	}
 
 	resBody := models.SignInBody{}
@@ -95,15 +189,70 @@ func (a *authentication) SignIn(force ...bool) error {
 		return ErrFailedUnmarshalResponseBody
 	}
 
-	ts := time.Now()
-	a.signInAt = &ts
-	a.accessToken = resBody.Credentials.Token
-	a.userID = *resBody.Credentials.User.ID
-	a.siteID = *resBody.Credentials.Site.ID
+	a.setSession(resBody.Credentials)
 
 	return nil
 }
 
+// SignInAs Signs you in as the specified user using connected app JSON Web Token.
+// The username is kept and used by subsequent automatic sign in.
+//
+// URI:
+//
+//	POST /api/api-version/auth/signin
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_authentication.htm#sign_in
+func (a *authentication) SignInAs(username string) error {
+	return a.SignInAsContext(context.Background(), username)
+}
+
+// SignInAsContext Same as SignInAs, using ctx to cancel the request.
+func (a *authentication) SignInAsContext(ctx context.Context, username string) error {
+	if a.base.cfg.ConnectedApp == nil {
+		return ErrConnectedAppNotConfigured
+	}
+
+	if username == "" {
+		return ErrInvalidConnectedApp
+	}
+
+	a.signInMu.Lock()
+	a.base.cfg.ConnectedApp.Username = username
+	a.signInMu.Unlock()
+
+	return a.SignInContext(ctx, true)
+}
+
+// ImpersonateUser Signs you in as the specified user using the configured server administrator credentials.
+// Subsequent calls, including automatic sign in, are made as the impersonated user.
+// Pass an empty user ID to sign in as the server administrator again.
+//
+// URI:
+//
+//	POST /api/api-version/auth/signin
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_authentication.htm#sign_in
+func (a *authentication) ImpersonateUser(userID string) error {
+	return a.ImpersonateUserContext(context.Background(), userID)
+}
+
+// ImpersonateUserContext Same as ImpersonateUser, using ctx to cancel the request.
+func (a *authentication) ImpersonateUserContext(ctx context.Context, userID string) error {
+	a.signInMu.Lock()
+	a.impersonateUserID = userID
+	a.signInMu.Unlock()
+
+	return a.SignInContext(ctx, true)
+}
+
+// ImpersonatedUserID return ID of impersonated user, or empty string when no user is impersonated.
+func (a *authentication) ImpersonatedUserID() string {
+	a.signInMu.Lock()
+	defer a.signInMu.Unlock()
+
+	return a.impersonateUserID
+}
+
 // SignOut Signs you out of the current session.
 // This call invalidates the authentication token that is created by a call to Sign In.
 //
@@ -113,6 +262,14 @@ func (a *authentication) SignIn(force ...bool) error {
 //
 // Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_authentication.htm#sign_out
 func (a *authentication) SignOut() error {
+	return a.SignOutContext(context.Background())
+}
+
+// SignOutContext Same as SignOut, using ctx to cancel the request.
+func (a *authentication) SignOutContext(ctx context.Context) error {
+	a.signInMu.Lock()
+	defer a.signInMu.Unlock()
+
 	if !a.IsSignedIn() {
 		return nil
 	}
@@ -122,35 +279,12 @@ func (a *authentication) SignOut() error {
 		return ErrInvalidHost
 	}
 
-	res, err := a.base.c.R().
-		SetHeader(contentTypeHeader, mimeTypeJSON).
-		SetHeader(acceptHeader, mimeTypeJSON).
-		SetHeader(authorizationHeader, a.getBearerToken()).
-		Post(url)
-
-	a.base.SetResponse(*res)
-	if err != nil {
-		errBody, err := models.NewErrorBody(res.Body())
-		if err != nil {
-			return ErrUnknownError
-		}
-
-		return errBody.Error
+	req := a.base.newRequest(ctx, mimeTypeJSON)
+	if _, err := a.base.executeOnce(req, http.MethodPost, url, http.StatusNoContent); err != nil {
+		return err
 	}
 
-	if res.StatusCode() != http.StatusNoContent {
-		errBody, err := models.NewErrorBody(res.Body())
-		if err != nil {
-			return ErrUnknownError
-		}
-
-		return errBody.Error
-	}
-
-	a.signInAt = nil
-	a.accessToken = ""
-	a.userID = ""
-	a.siteID = ""
+	a.setSession(nil)
 
 	return nil
 }
@@ -168,12 +302,20 @@ func (a *authentication) SignOut() error {
 //
 // Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_authentication.htm#switch_site
 func (a *authentication) SwitchSite(contentUrl string) error {
+	return a.SwitchSiteContext(context.Background(), contentUrl)
+}
+
+// SwitchSiteContext Same as SwitchSite, using ctx to cancel the request.
+func (a *authentication) SwitchSiteContext(ctx context.Context, contentUrl string) error {
 	if !a.IsSignedIn() {
-		if err := a.SignIn(); err != nil {
+		if err := a.SignInContext(ctx); err != nil {
 			return err
 		}
 	}
 
+	a.signInMu.Lock()
+	defer a.signInMu.Unlock()
+
 	if a.base.cfg.ContentUrl == contentUrl {
 		return nil
 	}
@@ -189,30 +331,10 @@ func (a *authentication) SwitchSite(contentUrl string) error {
 		return ErrInvalidHost
 	}
 
-	res, err := a.base.c.R().
-		SetHeader(contentTypeHeader, mimeTypeJSON).
-		SetHeader(acceptHeader, mimeTypeJSON).
-		SetHeader(authorizationHeader, a.getBearerToken()).
-		SetBody(reqBody).
-		Post(url)
-
-	a.base.SetResponse(*res)
+	req := a.base.newRequest(ctx, mimeTypeJSON).SetBody(reqBody)
+	res, err := a.base.executeOnce(req, http.MethodPost, url, http.StatusOK)
 	if err != nil {
-		errBody, err := models.NewErrorBody(res.Body())
-		if err != nil {
-			return ErrUnknownError
-		}
-
-		return errBody.Error
-	}
-
-	if res.StatusCode() != http.StatusOK {
-		errBody, err := models.NewErrorBody(res.Body())
-		if err != nil {
-			return ErrUnknownError
-		}
-
-		return errBody.Error
+		return err
 	}
 
 	resBody := models.SignInBody{}
@@ -220,11 +342,7 @@ func (a *authentication) SwitchSite(contentUrl string) error {
 		return ErrFailedUnmarshalResponseBody
 	}
 
-	ts := time.Now()
-	a.signInAt = &ts
-	a.accessToken = resBody.Credentials.Token
-	a.userID = *resBody.Credentials.User.ID
-	a.siteID = *resBody.Credentials.Site.ID
+	a.setSession(resBody.Credentials)
 	a.base.cfg.ContentUrl = contentUrl
 
 	return nil
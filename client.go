@@ -1,41 +1,116 @@
 package tableau
 
 import (
+	"context"
 	"github.com/go-resty/resty/v2"
+	"sync"
 )
 
+// Client is safe for concurrent use by multiple goroutines.
 type Client struct {
 	c              *resty.Client
+	mu             sync.RWMutex
 	r              *resty.Response
 	cfg            *Config
 	Authentication *authentication
 	UsersGroups    *usersGroups
 	WorkbooksViews *workbooksViews
+	Projects       *projects
+	DataSources    *dataSources
+	Jobs           *jobs
+	Schedules      *schedules
+	Subscriptions  *subscriptions
+	Favorites      *favorites
+	Sites          *sites
+	Server         *server
+	Metadata       *metadata
 }
 
+type responseKey struct{}
+
 // GetResponse return last response object returned by resty.Request.
+// When the client is used by multiple goroutines, the last response might belong to other goroutine,
+// use CaptureResponse to get the response of a specific call instead.
 func (c *Client) GetResponse() *resty.Response {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
 	return c.r
 }
 
 // SetResponse set last response object created by resty.Request.
 func (c *Client) SetResponse(r resty.Response) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	c.r = &r
 }
 
+// CaptureResponse return a copy of ctx that makes methods called using it store their response object into res.
+// When a method sends multiple requests, res holds the last one.
+func CaptureResponse(ctx context.Context, res *resty.Response) context.Context {
+	return context.WithValue(ctx, responseKey{}, res)
+}
+
 // NewClient Initialize a new Tableau client.
+// When DetectVersion of cfg is set, server info is requested to use the highest REST API version supported by the server.
 func NewClient(cfg Config) (*Client, error) {
 	if err := cfg.initConfig(); err != nil {
 		return nil, err
 	}
 
+	client := newClient(newRestClient(), &cfg)
+	if cfg.DetectVersion {
+		if err := client.Server.detectVersion(context.Background()); err != nil {
+			return nil, err
+		}
+	}
+
+	return client, nil
+}
+
+// Impersonate Runs fn using a new session signed in as the specified user, then signs out that session.
+// The session of this client is left untouched, so calls made after fn returns still use the original user.
+// The user that signed in must be a server administrator.
+func (c *Client) Impersonate(userID string, fn func(client *Client) error) error {
+	return c.ImpersonateContext(context.Background(), userID, fn)
+}
+
+// ImpersonateContext Same as Impersonate, using ctx to cancel the sign in request.
+// The session is signed out even when ctx is done.
+func (c *Client) ImpersonateContext(ctx context.Context, userID string, fn func(client *Client) error) error {
+	if userID == "" {
+		return ErrBadRequest
+	}
+
+	c.Authentication.signInMu.Lock()
+	cfg := c.cfg.clone()
+	c.Authentication.signInMu.Unlock()
+
+	// NOTE: The impersonated session must not store its session cookie into the cookie jar of this client
+	client := newClient(newRestClient().SetCookieJar(nil), &cfg)
+	client.Authentication.impersonateUserID = userID
+	if err := client.Authentication.SignInContext(ctx); err != nil {
+		return err
+	}
+
+	defer func() { _ = client.Authentication.SignOutContext(context.Background()) }()
+
+	return fn(client)
+}
+
+func newRestClient() *resty.Client {
 	restClient := resty.New()
 	restClient.JSONMarshal = json.Marshal
 	restClient.JSONUnmarshal = json.Unmarshal
 
+	return restClient
+}
+
+func newClient(restClient *resty.Client, cfg *Config) *Client {
 	client := &Client{
 		c:   restClient,
-		cfg: &cfg,
+		cfg: cfg,
 	}
 
 	auth := &authentication{base: client}
@@ -47,5 +122,32 @@ func NewClient(cfg Config) (*Client, error) {
 	wv := &workbooksViews{base: client}
 	client.WorkbooksViews = wv
 
-	return client, nil
+	p := &projects{base: client}
+	client.Projects = p
+
+	ds := &dataSources{base: client}
+	client.DataSources = ds
+
+	j := &jobs{base: client}
+	client.Jobs = j
+
+	s := &schedules{base: client}
+	client.Schedules = s
+
+	sub := &subscriptions{base: client}
+	client.Subscriptions = sub
+
+	f := &favorites{base: client}
+	client.Favorites = f
+
+	site := &sites{base: client}
+	client.Sites = site
+
+	srv := &server{base: client}
+	client.Server = srv
+
+	md := &metadata{base: client}
+	client.Metadata = md
+
+	return client
 }
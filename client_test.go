@@ -0,0 +1,80 @@
+package tableau
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestImpersonateKeepsSession(t *testing.T) {
+	var signOuts int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(contentTypeHeader, mimeTypeJSON)
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/auth/signin"):
+			body, _ := io.ReadAll(r.Body)
+			token := "admin"
+			if strings.Contains(string(body), `"user":{"id":"user"}`) {
+				token = "user"
+			}
+
+			http.SetCookie(w, &http.Cookie{Name: "workgroup_session_id", Value: token, Path: "/"})
+			_, _ = w.Write([]byte(`{"credentials":{"token":"` + token + `","site":{"id":"site"},"user":{"id":"` + token + `"}}}`))
+			return
+		case strings.HasSuffix(r.URL.Path, "/auth/signout"):
+			if token := r.Header.Get(authorizationHeader); token != "Bearer user" {
+				t.Errorf("unexpected sign out token %q", token)
+			}
+
+			atomic.AddInt32(&signOuts, 1)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		token := strings.TrimPrefix(r.Header.Get(authorizationHeader), "Bearer ")
+		if cookie, err := r.Cookie("workgroup_session_id"); err == nil && cookie.Value != token {
+			t.Errorf("token %s: unexpected session cookie %q", token, cookie.Value)
+		}
+
+		_, _ = w.Write([]byte(`{"pagination":{"pageNumber":"1","pageSize":"500","totalAvailable":"1"},"users":{"user":[{"id":"` + token + `"}]}}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(Config{Host: srv.URL, Username: "username", Password: "password"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertUser := func(c *Client, expected string) {
+		users, err := c.UsersGroups.GetUsersOnSite()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(users) != 1 || users[0].ID == nil || *users[0].ID != expected {
+			t.Errorf("expected request made as %s", expected)
+		}
+	}
+
+	assertUser(client, "admin")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	err = client.ImpersonateContext(ctx, "user", func(c *Client) error {
+		defer cancel()
+		assertUser(c, "user")
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n := atomic.LoadInt32(&signOuts); n != 1 {
+		t.Errorf("expected impersonated session signed out once, got %d", n)
+	}
+
+	assertUser(client, "admin")
+}
@@ -4,15 +4,36 @@ import (
 	"fmt"
 	"net/url"
 	"path"
+	"strconv"
 	"strings"
+	"time"
 )
 
 type Config struct {
-	Host       string
-	Version    string
-	Username   string
-	Password   string
-	ContentUrl string
+	Host                      string
+	Version                   string
+	Username                  string
+	Password                  string
+	PersonalAccessTokenName   string
+	PersonalAccessTokenSecret string
+	ConnectedApp              *ConnectedApp
+	ContentUrl                string
+	Retry                     *RetryPolicy
+
+	// DetectVersion makes NewClient use the highest REST API version supported by the server instead of Version.
+	DetectVersion bool
+}
+
+// ConnectedApp holds Tableau connected app configuration used to sign in using JSON Web Token.
+// Username is the user that will be signed in (the JWT sub claim).
+// Connected apps require REST API version 3.14 or later, which is used by default when Version is empty.
+type ConnectedApp struct {
+	ClientID    string
+	SecretID    string
+	SecretValue string
+	Username    string
+	Scopes      []string
+	Expiry      time.Duration
 }
 
 func (c *Config) initConfig() error {
@@ -25,6 +46,33 @@ func (c *Config) initConfig() error {
 
 	if c.Version == "" {
 		c.Version = DefaultVersion
+		if c.ConnectedApp != nil {
+			c.Version = minVersionConnectedApp
+		}
+	}
+
+	if c.Retry != nil {
+		retry := *c.Retry
+		retry.init()
+		c.Retry = &retry
+	}
+
+	if c.ConnectedApp != nil {
+		if !c.DetectVersion && compareVersion(c.Version, minVersionConnectedApp) < 0 {
+			return &VersionError{Feature: "SignIn using connected app", Version: c.Version, MinVersion: minVersionConnectedApp}
+		}
+
+		// NOTE: The connected app is modified by init and SignInAs, so it must not be shared with the caller
+		*c = c.clone()
+		return c.ConnectedApp.init()
+	}
+
+	if c.PersonalAccessTokenName != "" || c.PersonalAccessTokenSecret != "" {
+		if c.PersonalAccessTokenName == "" || c.PersonalAccessTokenSecret == "" {
+			return ErrInvalidPersonalAccessToken
+		}
+
+		return nil
 	}
 
 	if c.Username == "" || c.Password == "" {
@@ -34,7 +82,50 @@ func (c *Config) initConfig() error {
 	return nil
 }
 
+// clone return a copy of c that does not share the connected app with c.
+func (c *Config) clone() Config {
+	cfg := *c
+	if cfg.ConnectedApp != nil {
+		app := *cfg.ConnectedApp
+		app.Scopes = append([]string(nil), app.Scopes...)
+		cfg.ConnectedApp = &app
+	}
+
+	return cfg
+}
+
+func (a *ConnectedApp) init() error {
+	if a.ClientID == "" || a.SecretID == "" || a.SecretValue == "" || a.Username == "" {
+		return ErrInvalidConnectedApp
+	}
+
+	if len(a.Scopes) == 0 {
+		a.Scopes = []string{defaultJWTScope}
+	}
+
+	if a.Expiry <= 0 || a.Expiry > maxJWTExpiry {
+		a.Expiry = maxJWTExpiry
+	}
+
+	return nil
+}
+
+// usePersonalAccessToken return true when personal access token should be used instead of username and password.
+func (c *Config) usePersonalAccessToken() bool {
+	return c.PersonalAccessTokenName != "" && c.PersonalAccessTokenSecret != ""
+}
+
 func (c *Config) GetUrl(paths ...string) string {
+	return c.getVersionUrl(c.Version, paths...)
+}
+
+// getVersionUrl same as GetUrl, using the specified REST API version instead of Version.
+func (c *Config) getVersionUrl(version string, paths ...string) string {
+	return c.getHostUrl(append([]string{fmt.Sprintf("/api/%s", version)}, paths...)...)
+}
+
+// getHostUrl return URL of paths relative to the host, used by APIs not versioned like the REST API.
+func (c *Config) getHostUrl(paths ...string) string {
 	u, err := url.Parse(c.Host)
 	if err != nil {
 		return ""
@@ -42,9 +133,34 @@ func (c *Config) GetUrl(paths ...string) string {
 
 	var ps []string
 	ps = append(ps, u.Path)
-	ps = append(ps, fmt.Sprintf("/api/%s", c.Version))
 	ps = append(ps, paths...)
 	u.Path = path.Join(ps...)
 
 	return u.String()
 }
+
+// compareVersion compares REST API versions a and b numerically, so 3.10 is newer than 3.9.
+// It returns -1 when a is older than b, 1 when a is newer than b, otherwise 0.
+func compareVersion(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var x, y int
+		if i < len(as) {
+			x, _ = strconv.Atoi(as[i])
+		}
+
+		if i < len(bs) {
+			y, _ = strconv.Atoi(bs[i])
+		}
+
+		if x < y {
+			return -1
+		}
+
+		if x > y {
+			return 1
+		}
+	}
+
+	return 0
+}
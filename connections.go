@@ -0,0 +1,77 @@
+package tableau
+
+import (
+	"context"
+	"fmt"
+	"github.com/tiketdatarisal/tableau/models"
+	"net/http"
+)
+
+// queryConnections returns connections of the resource identified by uri and args.
+func (c *Client) queryConnections(ctx context.Context, uri string, args ...any) ([]models.Connection, error) {
+	if !c.Authentication.IsSignedIn() {
+		if err := c.Authentication.SignInContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	url := c.cfg.GetUrl(fmt.Sprintf(uri, append([]any{c.Authentication.getSiteID()}, args...)...))
+	if url == "" {
+		return nil, ErrInvalidHost
+	}
+
+	req := c.newRequest(ctx, mimeTypeJSON)
+	res, err := c.execute(req, http.MethodGet, url, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody := models.QueryConnectionBody{}
+	if err = json.Unmarshal(res.Body(), &resBody); err != nil {
+		return nil, ErrFailedUnmarshalResponseBody
+	}
+
+	if resBody.Connections == nil {
+		return nil, nil
+	}
+
+	return resBody.Connections.Connection, nil
+}
+
+// updateConnection updates server address, port, username, password and embed password of the connection identified by uri and args.
+func (c *Client) updateConnection(ctx context.Context, connection *models.Connection, uri string, args ...any) (*models.Connection, error) {
+	if !c.Authentication.IsSignedIn() {
+		if err := c.Authentication.SignInContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	reqBody := models.ConnectionBody{
+		Connection: &models.Connection{
+			ServerAddress:       connection.ServerAddress,
+			ServerPort:          connection.ServerPort,
+			UserName:            connection.UserName,
+			Password:            connection.Password,
+			EmbedPassword:       connection.EmbedPassword,
+			QueryTaggingEnabled: connection.QueryTaggingEnabled,
+		},
+	}
+
+	url := c.cfg.GetUrl(fmt.Sprintf(uri, append([]any{c.Authentication.getSiteID()}, args...)...))
+	if url == "" {
+		return nil, ErrInvalidHost
+	}
+
+	req := retryIdempotent(c.newRequest(ctx, mimeTypeJSON).SetBody(reqBody))
+	res, err := c.execute(req, http.MethodPut, url, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody := models.ConnectionBody{}
+	if err = json.Unmarshal(res.Body(), &resBody); err != nil {
+		return nil, ErrFailedUnmarshalResponseBody
+	}
+
+	return resBody.Connection, nil
+}
@@ -0,0 +1,450 @@
+package tableau
+
+import (
+	"context"
+	"fmt"
+	"github.com/tiketdatarisal/tableau/models"
+	"io"
+	"net/http"
+	. "net/url"
+)
+
+type dataSources struct {
+	base *Client
+}
+
+// AddDataSourcePermissions Adds permissions to the specified data source for a user or group.
+// Permissions returned by QueryDataSourcePermissions can be passed as grantees.
+//
+// URI:
+//
+//	PUT /api/api-version/sites/site-id/datasources/datasource-id/permissions
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_permissions.htm#add_data_source_permissions
+func (d *dataSources) AddDataSourcePermissions(dataSourceID string, grantees []models.GranteeCapabilities) (*models.Permissions, error) {
+	return d.AddDataSourcePermissionsContext(context.Background(), dataSourceID, grantees)
+}
+
+// AddDataSourcePermissionsContext Same as AddDataSourcePermissions, using ctx to cancel the request.
+func (d *dataSources) AddDataSourcePermissionsContext(ctx context.Context, dataSourceID string, grantees []models.GranteeCapabilities) (*models.Permissions, error) {
+	return d.base.addPermissions(ctx, grantees, dataSourcePermissionsUri, dataSourceID)
+}
+
+// AddTagsToDataSource Adds one or more tags to the specified data source.
+//
+// URI:
+//
+//	PUT /api/api-version/sites/site-id/datasources/datasource-id/tags
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_data_sources.htm#add_tags_to_data_source
+func (d *dataSources) AddTagsToDataSource(dataSourceID string, tagNames []string) ([]models.Tag, error) {
+	return d.AddTagsToDataSourceContext(context.Background(), dataSourceID, tagNames)
+}
+
+// AddTagsToDataSourceContext Same as AddTagsToDataSource, using ctx to cancel the request.
+func (d *dataSources) AddTagsToDataSourceContext(ctx context.Context, dataSourceID string, tagNames []string) ([]models.Tag, error) {
+	if !d.base.Authentication.IsSignedIn() {
+		if err := d.base.Authentication.SignInContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	var tags []models.Tag
+	for _, tagName := range tagNames {
+		if tagName != "" {
+			tags = append(tags, models.Tag{Label: tagName})
+		}
+	}
+
+	if len(tags) == 0 {
+		return nil, ErrBadRequest
+	}
+
+	reqBody := models.TagBody{
+		Tags: (*struct {
+			Tag []models.Tag `json:"tag,omitempty"`
+		})(&struct{ Tag []models.Tag }{Tag: tags}),
+	}
+
+	url := d.base.cfg.GetUrl(fmt.Sprintf(addTagsToDataSourceUri, d.base.Authentication.getSiteID(), dataSourceID))
+	if url == "" {
+		return nil, ErrInvalidHost
+	}
+
+	req := d.base.newRequest(ctx, mimeTypeJSON).SetBody(reqBody)
+	res, err := d.base.execute(req, http.MethodPut, url, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody := models.TagBody{}
+	if err = json.Unmarshal(res.Body(), &resBody); err != nil {
+		return nil, ErrFailedUnmarshalResponseBody
+	}
+
+	if resBody.Tags == nil {
+		return nil, nil
+	}
+
+	return resBody.Tags.Tag, nil
+}
+
+// DeleteDataSource Deletes the specified data source from a site.
+// When a data source is deleted, its associated data connection is also deleted.
+// Workbooks that use the data source are not deleted, but they will no longer work properly.
+//
+// URI:
+//
+//	DELETE /api/api-version/sites/site-id/datasources/datasource-id
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_data_sources.htm#delete_data_source
+func (d *dataSources) DeleteDataSource(dataSourceID string) error {
+	return d.DeleteDataSourceContext(context.Background(), dataSourceID)
+}
+
+// DeleteDataSourceContext Same as DeleteDataSource, using ctx to cancel the request.
+func (d *dataSources) DeleteDataSourceContext(ctx context.Context, dataSourceID string) error {
+	if !d.base.Authentication.IsSignedIn() {
+		if err := d.base.Authentication.SignInContext(ctx); err != nil {
+			return err
+		}
+	}
+
+	url := d.base.cfg.GetUrl(fmt.Sprintf(deleteDataSourceUri, d.base.Authentication.getSiteID(), dataSourceID))
+	if url == "" {
+		return ErrInvalidHost
+	}
+
+	req := d.base.newRequest(ctx, mimeTypeJSON)
+	if _, err := d.base.execute(req, http.MethodDelete, url, http.StatusNoContent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteDataSourcePermissions Deletes every capability of the grantee from the specified data source permissions.
+// The grantee must be either a user or a group with its ID.
+//
+// URI:
+//
+//	DELETE /api/api-version/sites/site-id/datasources/datasource-id/permissions/groups/group-id/capability-name/capability-mode
+//	DELETE /api/api-version/sites/site-id/datasources/datasource-id/permissions/users/user-id/capability-name/capability-mode
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_permissions.htm#delete_data_source_permission
+func (d *dataSources) DeleteDataSourcePermissions(dataSourceID string, grantee models.GranteeCapabilities) error {
+	return d.DeleteDataSourcePermissionsContext(context.Background(), dataSourceID, grantee)
+}
+
+// DeleteDataSourcePermissionsContext Same as DeleteDataSourcePermissions, using ctx to cancel the requests.
+func (d *dataSources) DeleteDataSourcePermissionsContext(ctx context.Context, dataSourceID string, grantee models.GranteeCapabilities) error {
+	return d.base.deletePermissions(ctx, grantee, dataSourcePermissionsUri, dataSourceID)
+}
+
+// DeleteTagFromDataSource Deletes a tag from the specified data source.
+//
+// URI:
+//
+//	DELETE /api/api-version/sites/site-id/datasources/datasource-id/tags/tag-name
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_data_sources.htm#delete_tag_from_data_source
+func (d *dataSources) DeleteTagFromDataSource(dataSourceID, tagName string) error {
+	return d.DeleteTagFromDataSourceContext(context.Background(), dataSourceID, tagName)
+}
+
+// DeleteTagFromDataSourceContext Same as DeleteTagFromDataSource, using ctx to cancel the request.
+func (d *dataSources) DeleteTagFromDataSourceContext(ctx context.Context, dataSourceID, tagName string) error {
+	if !d.base.Authentication.IsSignedIn() {
+		if err := d.base.Authentication.SignInContext(ctx); err != nil {
+			return err
+		}
+	}
+
+	url := d.base.cfg.GetUrl(fmt.Sprintf(deleteTagFromDataSourceUri, d.base.Authentication.getSiteID(), dataSourceID, QueryEscape(tagName)))
+	if url == "" {
+		return ErrInvalidHost
+	}
+
+	req := d.base.newRequest(ctx, mimeTypeJSON)
+	if _, err := d.base.execute(req, http.MethodDelete, url, http.StatusNoContent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DownloadDataSource Downloads the specified data source in .tds or .tdsx format into w, and returns its file name.
+// The extract is included by default, set includeExtract to false to download the data source without its extract.
+//
+// URI:
+//
+//	GET /api/api-version/sites/site-id/datasources/datasource-id/content?includeExtract=extract-value
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_data_sources.htm#download_data_source
+func (d *dataSources) DownloadDataSource(dataSourceID string, w io.Writer, includeExtract ...bool) (string, error) {
+	return d.DownloadDataSourceContext(context.Background(), dataSourceID, w, includeExtract...)
+}
+
+// DownloadDataSourceContext Same as DownloadDataSource, using ctx to cancel the request.
+func (d *dataSources) DownloadDataSourceContext(ctx context.Context, dataSourceID string, w io.Writer, includeExtract ...bool) (string, error) {
+	if !d.base.Authentication.IsSignedIn() {
+		if err := d.base.Authentication.SignInContext(ctx); err != nil {
+			return "", err
+		}
+	}
+
+	extract := true
+	if len(includeExtract) > 0 {
+		extract = includeExtract[0]
+	}
+
+	url := d.base.cfg.GetUrl(fmt.Sprintf(downloadDataSourceUri, d.base.Authentication.getSiteID(), dataSourceID))
+	if url == "" {
+		return "", ErrInvalidHost
+	}
+
+	url = fmt.Sprintf(downloadDataSourceParams, url, extract)
+
+	req := d.base.newRequest(ctx, mimeTypeAny)
+	return d.base.download(req, url, w)
+}
+
+// QueryDataSource Returns information about the specified data source.
+//
+// URI:
+//
+//	GET /api/api-version/sites/site-id/datasources/datasource-id
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_data_sources.htm#query_data_source
+func (d *dataSources) QueryDataSource(dataSourceID string) (*models.DataSource, error) {
+	return d.QueryDataSourceContext(context.Background(), dataSourceID)
+}
+
+// QueryDataSourceContext Same as QueryDataSource, using ctx to cancel the request.
+func (d *dataSources) QueryDataSourceContext(ctx context.Context, dataSourceID string) (*models.DataSource, error) {
+	if !d.base.Authentication.IsSignedIn() {
+		if err := d.base.Authentication.SignInContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	url := d.base.cfg.GetUrl(fmt.Sprintf(queryDataSourceUri, d.base.Authentication.getSiteID(), dataSourceID))
+	if url == "" {
+		return nil, ErrInvalidHost
+	}
+
+	req := d.base.newRequest(ctx, mimeTypeJSON)
+	res, err := d.base.execute(req, http.MethodGet, url, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody := models.DataSourceBody{}
+	if err = json.Unmarshal(res.Body(), &resBody); err != nil {
+		return nil, ErrFailedUnmarshalResponseBody
+	}
+
+	return resBody.DataSource, nil
+}
+
+// QueryDataSourceConnections Returns a list of data connections for the specified data source.
+//
+// URI:
+//
+//	GET /api/api-version/sites/site-id/datasources/datasource-id/connections
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_data_sources.htm#query_data_source_connections
+func (d *dataSources) QueryDataSourceConnections(dataSourceID string) ([]models.Connection, error) {
+	return d.QueryDataSourceConnectionsContext(context.Background(), dataSourceID)
+}
+
+// QueryDataSourceConnectionsContext Same as QueryDataSourceConnections, using ctx to cancel the request.
+func (d *dataSources) QueryDataSourceConnectionsContext(ctx context.Context, dataSourceID string) ([]models.Connection, error) {
+	return d.base.queryConnections(ctx, queryDataSourceConnectionsUri, dataSourceID)
+}
+
+// QueryDataSourcePermissions Returns a list of permissions for the specified data source.
+//
+// URI:
+//
+//	GET /api/api-version/sites/site-id/datasources/datasource-id/permissions
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_permissions.htm#query_data_source_permissions
+func (d *dataSources) QueryDataSourcePermissions(dataSourceID string) (*models.Permissions, error) {
+	return d.QueryDataSourcePermissionsContext(context.Background(), dataSourceID)
+}
+
+// QueryDataSourcePermissionsContext Same as QueryDataSourcePermissions, using ctx to cancel the request.
+func (d *dataSources) QueryDataSourcePermissionsContext(ctx context.Context, dataSourceID string) (*models.Permissions, error) {
+	return d.base.queryPermissions(ctx, dataSourcePermissionsUri, dataSourceID)
+}
+
+// QueryDataSources Returns a list of published data sources on the specified site, optionally filtered and sorted.
+//
+// URI:
+//
+//	GET /api/api-version/sites/site-id/datasources
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_data_sources.htm#query_data_sources
+func (d *dataSources) QueryDataSources(f models.Filter, s models.Sort) ([]models.DataSource, error) {
+	return d.QueryDataSourcesContext(context.Background(), f, s)
+}
+
+// QueryDataSourcesContext Same as QueryDataSources, using ctx to cancel the requests.
+// No more pages are requested after ctx is done.
+func (d *dataSources) QueryDataSourcesContext(ctx context.Context, f models.Filter, s models.Sort) ([]models.DataSource, error) {
+	return d.QueryDataSourcesPager(PageOptions{}, f, s).All(ctx)
+}
+
+// QueryDataSourcesPager Same as QueryDataSources, returning a pager that requests the data sources page by page.
+func (d *dataSources) QueryDataSourcesPager(opt PageOptions, f models.Filter, s models.Sort) *Pager[models.DataSource] {
+	return newPager(d.base, opt, func(ctx context.Context, size, num int) ([]models.DataSource, *models.Pagination, error) {
+		url := d.base.cfg.GetUrl(fmt.Sprintf(queryDataSourcesUri, d.base.Authentication.getSiteID()))
+		if url == "" {
+			return nil, nil, ErrInvalidHost
+		}
+
+		url = fmt.Sprintf(queryDataSourcesParams, url, size, num)
+		if len(f) > 0 {
+			url = url + "&filter=" + f.String()
+		}
+
+		if len(s) > 0 {
+			url = url + "&sort=" + s.String()
+		}
+
+		req := d.base.newRequest(ctx, mimeTypeJSON)
+		res, err := d.base.execute(req, http.MethodGet, url, http.StatusOK)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		resBody := models.QueryDataSourceBody{}
+		if err = json.Unmarshal(res.Body(), &resBody); err != nil {
+			return nil, nil, ErrFailedUnmarshalResponseBody
+		}
+
+		if resBody.DataSources == nil {
+			return nil, resBody.Pagination, nil
+		}
+
+		return resBody.DataSources.DataSource, resBody.Pagination, nil
+	})
+}
+
+// UpdateDataSource Updates the name, owner, project or certification of the specified data source.
+// To move the data source, set Project with the ID of the target project.
+//
+// URI:
+//
+//	PUT /api/api-version/sites/site-id/datasources/datasource-id
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_data_sources.htm#update_data_source
+func (d *dataSources) UpdateDataSource(dataSource *models.DataSource) (*models.DataSource, error) {
+	return d.UpdateDataSourceContext(context.Background(), dataSource)
+}
+
+// UpdateDataSourceContext Same as UpdateDataSource, using ctx to cancel the request.
+func (d *dataSources) UpdateDataSourceContext(ctx context.Context, dataSource *models.DataSource) (*models.DataSource, error) {
+	if !d.base.Authentication.IsSignedIn() {
+		if err := d.base.Authentication.SignInContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if dataSource == nil || dataSource.ID == nil {
+		return nil, ErrBadRequest
+	}
+
+	reqBody := models.DataSourceBody{
+		DataSource: &models.DataSource{
+			Name:              dataSource.Name,
+			IsCertified:       dataSource.IsCertified,
+			CertificationNote: dataSource.CertificationNote,
+			EncryptExtracts:   dataSource.EncryptExtracts,
+			Owner:             dataSource.Owner,
+		},
+	}
+
+	if dataSource.Project != nil {
+		reqBody.DataSource.Project = &models.Project{ID: dataSource.Project.ID}
+	}
+
+	url := d.base.cfg.GetUrl(fmt.Sprintf(updateDataSourceUri, d.base.Authentication.getSiteID(), *dataSource.ID))
+	if url == "" {
+		return nil, ErrInvalidHost
+	}
+
+	req := retryIdempotent(d.base.newRequest(ctx, mimeTypeJSON).SetBody(reqBody))
+	res, err := d.base.execute(req, http.MethodPut, url, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody := models.DataSourceBody{}
+	if err = json.Unmarshal(res.Body(), &resBody); err != nil {
+		return nil, ErrFailedUnmarshalResponseBody
+	}
+
+	return resBody.DataSource, nil
+}
+
+// UpdateDataSourceConnection Updates the server address, port, username, or password for the specified data source connection.
+// Set EmbedPassword to embed the password into the connection.
+//
+// URI:
+//
+//	PUT /api/api-version/sites/site-id/datasources/datasource-id/connections/connection-id
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_data_sources.htm#update_data_source_connection
+func (d *dataSources) UpdateDataSourceConnection(dataSourceID string, connection *models.Connection) (*models.Connection, error) {
+	return d.UpdateDataSourceConnectionContext(context.Background(), dataSourceID, connection)
+}
+
+// UpdateDataSourceConnectionContext Same as UpdateDataSourceConnection, using ctx to cancel the request.
+func (d *dataSources) UpdateDataSourceConnectionContext(ctx context.Context, dataSourceID string, connection *models.Connection) (*models.Connection, error) {
+	if connection == nil || connection.ID == nil {
+		return nil, ErrBadRequest
+	}
+
+	return d.base.updateConnection(ctx, connection, updateDataSourceConnectionUri, dataSourceID, *connection.ID)
+}
+
+// UpdateDataSourceNow Runs an extract refresh on the specified data source, and returns the extract refresh job.
+// Use Jobs.WaitForJob to wait until the refresh is completed.
+//
+// URI:
+//
+//	POST /api/api-version/sites/site-id/datasources/datasource-id/refresh
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_data_sources.htm#update_data_source_now
+func (d *dataSources) UpdateDataSourceNow(dataSourceID string) (*models.Job, error) {
+	return d.UpdateDataSourceNowContext(context.Background(), dataSourceID)
+}
+
+// UpdateDataSourceNowContext Same as UpdateDataSourceNow, using ctx to cancel the request.
+func (d *dataSources) UpdateDataSourceNowContext(ctx context.Context, dataSourceID string) (*models.Job, error) {
+	if !d.base.Authentication.IsSignedIn() {
+		if err := d.base.Authentication.SignInContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	url := d.base.cfg.GetUrl(fmt.Sprintf(updateDataSourceNowUri, d.base.Authentication.getSiteID(), dataSourceID))
+	if url == "" {
+		return nil, ErrInvalidHost
+	}
+
+	req := d.base.newRequest(ctx, mimeTypeJSON).SetBody(struct{}{})
+	res, err := d.base.execute(req, http.MethodPost, url, http.StatusAccepted)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody := models.JobBody{}
+	if err = json.Unmarshal(res.Body(), &resBody); err != nil {
+		return nil, ErrFailedUnmarshalResponseBody
+	}
+
+	return resBody.Job, nil
+}
@@ -0,0 +1,216 @@
+package tableau
+
+import (
+	"fmt"
+	"github.com/go-resty/resty/v2"
+	"github.com/tiketdatarisal/tableau/models"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// APIError is returned when Tableau responds with an unexpected status code.
+// It unwraps to the sentinel error matching its code, so it can be compared using errors.Is, for example:
+//
+//	if errors.Is(err, tableau.ErrUserAlreadyOnSite) {
+//		...
+//	}
+type APIError struct {
+	StatusCode int
+	Code       string
+	Summary    string
+	Detail     string
+	Method     string
+	URL        string
+}
+
+func (e *APIError) Error() string {
+	msg := e.Detail
+	if msg == "" {
+		msg = e.Summary
+	}
+
+	if msg == "" {
+		msg = http.StatusText(e.StatusCode)
+	}
+
+	if e.Code == "" {
+		return fmt.Sprintf("%s %s: %d: %s", e.Method, e.URL, e.StatusCode, msg)
+	}
+
+	return fmt.Sprintf("%s %s: %d: %s (%s)", e.Method, e.URL, e.StatusCode, msg, e.Code)
+}
+
+// Unwrap return the sentinel error matching the error code.
+// When the response has no error code, the code is derived from its HTTP status code,
+// only for status codes whose sentinel error is generic (401, 429 and 500).
+// ErrUnknownError is returned for unknown codes.
+func (e *APIError) Unwrap() error {
+	code := e.Code
+	if code == "" {
+		switch e.StatusCode {
+		case http.StatusUnauthorized, http.StatusTooManyRequests, http.StatusInternalServerError:
+			code = strconv.Itoa(e.StatusCode * 1000)
+		default:
+			return ErrUnknownError
+		}
+	}
+
+	if err, ok := errCodeMap[code]; ok {
+		return err
+	}
+
+	return ErrUnknownError
+}
+
+// TransportError is returned when a request could not be sent or its response could not be received.
+type TransportError struct {
+	Method string
+	URL    string
+	Err    error
+}
+
+func (e *TransportError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *TransportError) Unwrap() error {
+	return e.Err
+}
+
+// JobError is returned when a job that was waited for failed or was cancelled.
+// It unwraps to ErrJobFailed or ErrJobCancelled depending on the finish code of the job.
+type JobError struct {
+	JobID      string
+	FinishCode string
+	Notes      []string
+}
+
+func (e *JobError) Error() string {
+	msg := fmt.Sprintf("job %s: %v", e.JobID, e.Unwrap())
+	if len(e.Notes) == 0 {
+		return msg
+	}
+
+	return msg + ": " + strings.Join(e.Notes, "; ")
+}
+
+// Unwrap return ErrJobCancelled for cancelled jobs, otherwise ErrJobFailed.
+func (e *JobError) Unwrap() error {
+	if e.FinishCode == models.JobFinishCodeCancelled {
+		return ErrJobCancelled
+	}
+
+	return ErrJobFailed
+}
+
+// VersionError is returned without sending any request when a method requires a newer REST API version than the client uses.
+// It unwraps to ErrVersionNotSupported.
+type VersionError struct {
+	Feature    string
+	Version    string
+	MinVersion string
+}
+
+func (e *VersionError) Error() string {
+	return fmt.Sprintf("%s requires REST API version %s or later, but version %s is used", e.Feature, e.MinVersion, e.Version)
+}
+
+func (e *VersionError) Unwrap() error {
+	return ErrVersionNotSupported
+}
+
+// GraphQLError is returned when the Metadata API responds with GraphQL errors. It unwraps to ErrGraphQL.
+type GraphQLError struct {
+	Errors []models.GraphQLError
+}
+
+func (e *GraphQLError) Error() string {
+	msgs := make([]string, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		msgs = append(msgs, err.Message)
+	}
+
+	return fmt.Sprintf("%v: %s", ErrGraphQL, strings.Join(msgs, "; "))
+}
+
+func (e *GraphQLError) Unwrap() error {
+	return ErrGraphQL
+}
+
+// SiteErrors is returned by MultiSiteClient methods when some sites failed, holding the error of each site by its content URL.
+type SiteErrors map[string]error
+
+func (e SiteErrors) Error() string {
+	contentUrls := make([]string, 0, len(e))
+	for contentUrl := range e {
+		contentUrls = append(contentUrls, contentUrl)
+	}
+
+	sort.Strings(contentUrls)
+
+	msgs := make([]string, 0, len(contentUrls))
+	for _, contentUrl := range contentUrls {
+		msgs = append(msgs, fmt.Sprintf("site %q: %v", contentUrl, e[contentUrl]))
+	}
+
+	return strings.Join(msgs, "; ")
+}
+
+// newJobError return JobError of job, including its status notes and extract refresh notes.
+func newJobError(job *models.Job) error {
+	jobErr := &JobError{}
+	if job.ID != nil {
+		jobErr.JobID = *job.ID
+	}
+
+	if job.FinishCode != nil {
+		jobErr.FinishCode = *job.FinishCode
+	}
+
+	if job.StatusNotes != nil {
+		for _, note := range job.StatusNotes.StatusNote {
+			if note.Text != nil && *note.Text != "" {
+				jobErr.Notes = append(jobErr.Notes, *note.Text)
+			} else if note.Value != nil && *note.Value != "" {
+				jobErr.Notes = append(jobErr.Notes, *note.Value)
+			}
+		}
+	}
+
+	if job.ExtractRefreshJob != nil && job.ExtractRefreshJob.Notes != nil && *job.ExtractRefreshJob.Notes != "" {
+		jobErr.Notes = append(jobErr.Notes, *job.ExtractRefreshJob.Notes)
+	}
+
+	return jobErr
+}
+
+// newAPIError return error parsed from Tableau error response body, either in JSON or XML format.
+func newAPIError(res *resty.Response) error {
+	apiErr := &APIError{
+		StatusCode: res.StatusCode(),
+		Method:     res.Request.Method,
+		URL:        res.Request.URL,
+	}
+
+	body := res.Body()
+	if raw := res.RawBody(); body == nil && raw != nil {
+		body, _ = io.ReadAll(raw)
+		_ = raw.Close()
+	}
+
+	errBody, err := models.NewErrorBody(body)
+	if err != nil || errBody.Error == nil {
+		errBody, err = models.NewErrorBodyXML(body)
+	}
+
+	if err == nil && errBody.Error != nil {
+		apiErr.Code = errBody.Error.Code
+		apiErr.Summary = errBody.Error.Summary
+		apiErr.Detail = errBody.Error.Detail
+	}
+
+	return apiErr
+}
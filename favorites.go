@@ -0,0 +1,295 @@
+package tableau
+
+import (
+	"context"
+	"fmt"
+	"github.com/tiketdatarisal/tableau/models"
+	"net/http"
+)
+
+type favorites struct {
+	base *Client
+}
+
+// AddDataSourceToFavorites Adds the specified data source to the favorites of the user, using label as the favorite name,
+// and returns the favorites of the user.
+//
+// URI:
+//
+//	PUT /api/api-version/sites/site-id/favorites/user-id
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_favorites.htm#add_data_source_to_favorites
+func (f *favorites) AddDataSourceToFavorites(userID, dataSourceID, label string) ([]models.Favorite, error) {
+	return f.AddDataSourceToFavoritesContext(context.Background(), userID, dataSourceID, label)
+}
+
+// AddDataSourceToFavoritesContext Same as AddDataSourceToFavorites, using ctx to cancel the request.
+func (f *favorites) AddDataSourceToFavoritesContext(ctx context.Context, userID, dataSourceID, label string) ([]models.Favorite, error) {
+	return f.addFavorite(ctx, userID, &models.Favorite{Label: &label, DataSource: &models.DataSource{ID: &dataSourceID}})
+}
+
+// AddProjectToFavorites Adds the specified project to the favorites of the user, using label as the favorite name,
+// and returns the favorites of the user.
+//
+// URI:
+//
+//	PUT /api/api-version/sites/site-id/favorites/user-id
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_favorites.htm#add_project_to_favorites
+func (f *favorites) AddProjectToFavorites(userID, projectID, label string) ([]models.Favorite, error) {
+	return f.AddProjectToFavoritesContext(context.Background(), userID, projectID, label)
+}
+
+// AddProjectToFavoritesContext Same as AddProjectToFavorites, using ctx to cancel the request.
+func (f *favorites) AddProjectToFavoritesContext(ctx context.Context, userID, projectID, label string) ([]models.Favorite, error) {
+	return f.addFavorite(ctx, userID, &models.Favorite{Label: &label, Project: &models.Project{ID: &projectID}})
+}
+
+// AddViewToFavorites Adds the specified view to the favorites of the user, using label as the favorite name,
+// and returns the favorites of the user.
+//
+// URI:
+//
+//	PUT /api/api-version/sites/site-id/favorites/user-id
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_favorites.htm#add_view_to_favorites
+func (f *favorites) AddViewToFavorites(userID, viewID, label string) ([]models.Favorite, error) {
+	return f.AddViewToFavoritesContext(context.Background(), userID, viewID, label)
+}
+
+// AddViewToFavoritesContext Same as AddViewToFavorites, using ctx to cancel the request.
+func (f *favorites) AddViewToFavoritesContext(ctx context.Context, userID, viewID, label string) ([]models.Favorite, error) {
+	return f.addFavorite(ctx, userID, &models.Favorite{Label: &label, View: &models.View{ID: &viewID}})
+}
+
+// AddWorkbookToFavorites Adds the specified workbook to the favorites of the user, using label as the favorite name,
+// and returns the favorites of the user.
+//
+// URI:
+//
+//	PUT /api/api-version/sites/site-id/favorites/user-id
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_favorites.htm#add_workbook_to_favorites
+func (f *favorites) AddWorkbookToFavorites(userID, workbookID, label string) ([]models.Favorite, error) {
+	return f.AddWorkbookToFavoritesContext(context.Background(), userID, workbookID, label)
+}
+
+// AddWorkbookToFavoritesContext Same as AddWorkbookToFavorites, using ctx to cancel the request.
+func (f *favorites) AddWorkbookToFavoritesContext(ctx context.Context, userID, workbookID, label string) ([]models.Favorite, error) {
+	return f.addFavorite(ctx, userID, &models.Favorite{Label: &label, Workbook: &models.Workbook{ID: &workbookID}})
+}
+
+// DeleteDataSourceFromFavorites Deletes the specified data source from the favorites of the user.
+//
+// URI:
+//
+//	DELETE /api/api-version/sites/site-id/favorites/user-id/datasources/datasource-id
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_favorites.htm#delete_data_source_from_favorites
+func (f *favorites) DeleteDataSourceFromFavorites(userID, dataSourceID string) error {
+	return f.DeleteDataSourceFromFavoritesContext(context.Background(), userID, dataSourceID)
+}
+
+// DeleteDataSourceFromFavoritesContext Same as DeleteDataSourceFromFavorites, using ctx to cancel the request.
+func (f *favorites) DeleteDataSourceFromFavoritesContext(ctx context.Context, userID, dataSourceID string) error {
+	return f.deleteFavorite(ctx, userID, "datasources", dataSourceID)
+}
+
+// DeleteProjectFromFavorites Deletes the specified project from the favorites of the user.
+//
+// URI:
+//
+//	DELETE /api/api-version/sites/site-id/favorites/user-id/projects/project-id
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_favorites.htm#delete_project_from_favorites
+func (f *favorites) DeleteProjectFromFavorites(userID, projectID string) error {
+	return f.DeleteProjectFromFavoritesContext(context.Background(), userID, projectID)
+}
+
+// DeleteProjectFromFavoritesContext Same as DeleteProjectFromFavorites, using ctx to cancel the request.
+func (f *favorites) DeleteProjectFromFavoritesContext(ctx context.Context, userID, projectID string) error {
+	return f.deleteFavorite(ctx, userID, "projects", projectID)
+}
+
+// DeleteViewFromFavorites Deletes the specified view from the favorites of the user.
+//
+// URI:
+//
+//	DELETE /api/api-version/sites/site-id/favorites/user-id/views/view-id
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_favorites.htm#delete_view_from_favorites
+func (f *favorites) DeleteViewFromFavorites(userID, viewID string) error {
+	return f.DeleteViewFromFavoritesContext(context.Background(), userID, viewID)
+}
+
+// DeleteViewFromFavoritesContext Same as DeleteViewFromFavorites, using ctx to cancel the request.
+func (f *favorites) DeleteViewFromFavoritesContext(ctx context.Context, userID, viewID string) error {
+	return f.deleteFavorite(ctx, userID, "views", viewID)
+}
+
+// DeleteWorkbookFromFavorites Deletes the specified workbook from the favorites of the user.
+//
+// URI:
+//
+//	DELETE /api/api-version/sites/site-id/favorites/user-id/workbooks/workbook-id
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_favorites.htm#delete_workbook_from_favorites
+func (f *favorites) DeleteWorkbookFromFavorites(userID, workbookID string) error {
+	return f.DeleteWorkbookFromFavoritesContext(context.Background(), userID, workbookID)
+}
+
+// DeleteWorkbookFromFavoritesContext Same as DeleteWorkbookFromFavorites, using ctx to cancel the request.
+func (f *favorites) DeleteWorkbookFromFavoritesContext(ctx context.Context, userID, workbookID string) error {
+	return f.deleteFavorite(ctx, userID, "workbooks", workbookID)
+}
+
+// GetFavoritesForUser Returns a list of favorite projects, data sources, views and workbooks for a user.
+//
+// URI:
+//
+//	GET /api/api-version/sites/site-id/favorites/user-id
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_favorites.htm#get_favorites_for_user
+func (f *favorites) GetFavoritesForUser(userID string) ([]models.Favorite, error) {
+	return f.GetFavoritesForUserContext(context.Background(), userID)
+}
+
+// GetFavoritesForUserContext Same as GetFavoritesForUser, using ctx to cancel the requests.
+// No more pages are requested after ctx is done.
+func (f *favorites) GetFavoritesForUserContext(ctx context.Context, userID string) ([]models.Favorite, error) {
+	return f.GetFavoritesForUserPager(PageOptions{}, userID).All(ctx)
+}
+
+// GetFavoritesForUserPager Same as GetFavoritesForUser, returning a pager that requests the favorites page by page.
+func (f *favorites) GetFavoritesForUserPager(opt PageOptions, userID string) *Pager[models.Favorite] {
+	return newPager(f.base, opt, func(ctx context.Context, size, num int) ([]models.Favorite, *models.Pagination, error) {
+		url := f.base.cfg.GetUrl(fmt.Sprintf(getFavoritesUri, f.base.Authentication.getSiteID(), userID))
+		if url == "" {
+			return nil, nil, ErrInvalidHost
+		}
+
+		url = fmt.Sprintf(getFavoritesParams, url, size, num)
+
+		req := f.base.newRequest(ctx, mimeTypeJSON)
+		res, err := f.base.execute(req, http.MethodGet, url, http.StatusOK)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		resBody := models.QueryFavoriteBody{}
+		if err = json.Unmarshal(res.Body(), &resBody); err != nil {
+			return nil, nil, ErrFailedUnmarshalResponseBody
+		}
+
+		if resBody.Favorites == nil {
+			return nil, resBody.Pagination, nil
+		}
+
+		return resBody.Favorites.Favorite, resBody.Pagination, nil
+	})
+}
+
+// OrganizeFavorites Moves favorites of the user, each ordering moves a favorite after another favorite.
+// Requires REST API version 3.8 or later.
+//
+// URI:
+//
+//	PUT /api/api-version/sites/site-id/orderFavorites/user-id
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_favorites.htm#organize_favorites
+func (f *favorites) OrganizeFavorites(userID string, orderings []models.FavoriteOrdering) error {
+	return f.OrganizeFavoritesContext(context.Background(), userID, orderings)
+}
+
+// OrganizeFavoritesContext Same as OrganizeFavorites, using ctx to cancel the request.
+func (f *favorites) OrganizeFavoritesContext(ctx context.Context, userID string, orderings []models.FavoriteOrdering) error {
+	if err := f.base.requireVersion("OrganizeFavorites", minVersionOrganizeFavorites); err != nil {
+		return err
+	}
+
+	if !f.base.Authentication.IsSignedIn() {
+		if err := f.base.Authentication.SignInContext(ctx); err != nil {
+			return err
+		}
+	}
+
+	if len(orderings) == 0 {
+		return ErrBadRequest
+	}
+
+	reqBody := models.FavoriteOrderingBody{
+		FavoriteOrderings: (*struct {
+			FavoriteOrdering []models.FavoriteOrdering `json:"favoriteOrdering,omitempty"`
+		})(&struct{ FavoriteOrdering []models.FavoriteOrdering }{FavoriteOrdering: orderings}),
+	}
+
+	url := f.base.cfg.GetUrl(fmt.Sprintf(organizeFavoritesUri, f.base.Authentication.getSiteID(), userID))
+	if url == "" {
+		return ErrInvalidHost
+	}
+
+	req := f.base.newRequest(ctx, mimeTypeJSON).SetBody(reqBody)
+	if _, err := f.base.execute(req, http.MethodPut, url, http.StatusNoContent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// addFavorite adds favorite to the favorites of the user, and returns the favorites of the user.
+func (f *favorites) addFavorite(ctx context.Context, userID string, favorite *models.Favorite) ([]models.Favorite, error) {
+	if !f.base.Authentication.IsSignedIn() {
+		if err := f.base.Authentication.SignInContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if favorite.Label == nil || *favorite.Label == "" {
+		return nil, ErrBadRequest
+	}
+
+	reqBody := models.FavoriteBody{Favorite: favorite}
+
+	url := f.base.cfg.GetUrl(fmt.Sprintf(addFavoriteUri, f.base.Authentication.getSiteID(), userID))
+	if url == "" {
+		return nil, ErrInvalidHost
+	}
+
+	req := f.base.newRequest(ctx, mimeTypeJSON).SetBody(reqBody)
+	res, err := f.base.execute(req, http.MethodPut, url, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody := models.QueryFavoriteBody{}
+	if err = json.Unmarshal(res.Body(), &resBody); err != nil {
+		return nil, ErrFailedUnmarshalResponseBody
+	}
+
+	if resBody.Favorites == nil {
+		return nil, nil
+	}
+
+	return resBody.Favorites.Favorite, nil
+}
+
+// deleteFavorite deletes content identified by content type and ID from the favorites of the user.
+func (f *favorites) deleteFavorite(ctx context.Context, userID, contentType, contentID string) error {
+	if !f.base.Authentication.IsSignedIn() {
+		if err := f.base.Authentication.SignInContext(ctx); err != nil {
+			return err
+		}
+	}
+
+	url := f.base.cfg.GetUrl(fmt.Sprintf(deleteFavoriteUri, f.base.Authentication.getSiteID(), userID, contentType, contentID))
+	if url == "" {
+		return ErrInvalidHost
+	}
+
+	req := f.base.newRequest(ctx, mimeTypeJSON)
+	if _, err := f.base.execute(req, http.MethodDelete, url, http.StatusNoContent); err != nil {
+		return err
+	}
+
+	return nil
+}
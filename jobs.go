@@ -0,0 +1,177 @@
+package tableau
+
+import (
+	"context"
+	"fmt"
+	"github.com/tiketdatarisal/tableau/models"
+	"net/http"
+	"time"
+)
+
+type jobs struct {
+	base *Client
+}
+
+// CancelJob Cancels a job specified by job ID.
+// Only jobs that are not started yet or still in progress can be cancelled.
+//
+// URI:
+//
+//	PUT /api/api-version/sites/site-id/jobs/job-id
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_jobs_tasks_and_schedules.htm#cancel_job
+func (j *jobs) CancelJob(jobID string) error {
+	return j.CancelJobContext(context.Background(), jobID)
+}
+
+// CancelJobContext Same as CancelJob, using ctx to cancel the request.
+func (j *jobs) CancelJobContext(ctx context.Context, jobID string) error {
+	if !j.base.Authentication.IsSignedIn() {
+		if err := j.base.Authentication.SignInContext(ctx); err != nil {
+			return err
+		}
+	}
+
+	url := j.base.cfg.GetUrl(fmt.Sprintf(cancelJobUri, j.base.Authentication.getSiteID(), jobID))
+	if url == "" {
+		return ErrInvalidHost
+	}
+
+	req := j.base.newRequest(ctx, mimeTypeJSON)
+	if _, err := j.base.execute(req, http.MethodPut, url, http.StatusOK); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// QueryJob Returns status information about an asynchronous process that is tracked using a job,
+// including the notes of failed extract refresh jobs.
+//
+// URI:
+//
+//	GET /api/api-version/sites/site-id/jobs/job-id
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_jobs_tasks_and_schedules.htm#query_job
+func (j *jobs) QueryJob(jobID string) (*models.Job, error) {
+	return j.QueryJobContext(context.Background(), jobID)
+}
+
+// QueryJobContext Same as QueryJob, using ctx to cancel the request.
+func (j *jobs) QueryJobContext(ctx context.Context, jobID string) (*models.Job, error) {
+	if !j.base.Authentication.IsSignedIn() {
+		if err := j.base.Authentication.SignInContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	url := j.base.cfg.GetUrl(fmt.Sprintf(queryJobUri, j.base.Authentication.getSiteID(), jobID))
+	if url == "" {
+		return nil, ErrInvalidHost
+	}
+
+	req := j.base.newRequest(ctx, mimeTypeJSON)
+	res, err := j.base.execute(req, http.MethodGet, url, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody := models.JobBody{}
+	if err = json.Unmarshal(res.Body(), &resBody); err != nil {
+		return nil, ErrFailedUnmarshalResponseBody
+	}
+
+	return resBody.Job, nil
+}
+
+// QueryJobs Returns a list of active jobs on the specified site, optionally filtered and sorted.
+// For example, filter by status using models.Filter{"status": models.JobStatusInProgress}.
+//
+// URI:
+//
+//	GET /api/api-version/sites/site-id/jobs
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_jobs_tasks_and_schedules.htm#query_jobs
+func (j *jobs) QueryJobs(f models.Filter, s models.Sort) ([]models.BackgroundJob, error) {
+	return j.QueryJobsContext(context.Background(), f, s)
+}
+
+// QueryJobsContext Same as QueryJobs, using ctx to cancel the requests.
+// No more pages are requested after ctx is done.
+func (j *jobs) QueryJobsContext(ctx context.Context, f models.Filter, s models.Sort) ([]models.BackgroundJob, error) {
+	return j.QueryJobsPager(PageOptions{}, f, s).All(ctx)
+}
+
+// QueryJobsPager Same as QueryJobs, returning a pager that requests the jobs page by page.
+func (j *jobs) QueryJobsPager(opt PageOptions, f models.Filter, s models.Sort) *Pager[models.BackgroundJob] {
+	return newPager(j.base, opt, func(ctx context.Context, size, num int) ([]models.BackgroundJob, *models.Pagination, error) {
+		url := j.base.cfg.GetUrl(fmt.Sprintf(queryJobsUri, j.base.Authentication.getSiteID()))
+		if url == "" {
+			return nil, nil, ErrInvalidHost
+		}
+
+		url = fmt.Sprintf(queryJobsParams, url, size, num)
+		if len(f) > 0 {
+			url = url + "&filter=" + f.String()
+		}
+
+		if len(s) > 0 {
+			url = url + "&sort=" + s.String()
+		}
+
+		req := j.base.newRequest(ctx, mimeTypeJSON)
+		res, err := j.base.execute(req, http.MethodGet, url, http.StatusOK)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		resBody := models.QueryBackgroundJobBody{}
+		if err = json.Unmarshal(res.Body(), &resBody); err != nil {
+			return nil, nil, ErrFailedUnmarshalResponseBody
+		}
+
+		if resBody.BackgroundJobs == nil {
+			return nil, resBody.Pagination, nil
+		}
+
+		return resBody.BackgroundJobs.BackgroundJob, resBody.Pagination, nil
+	})
+}
+
+// WaitForJob Polls the specified job every interval until it is completed, and returns the completed job.
+// When the job failed or was cancelled, the job is returned along with JobError containing the job notes.
+// Default poll interval is 5 seconds.
+func (j *jobs) WaitForJob(jobID string, interval ...time.Duration) (*models.Job, error) {
+	return j.WaitForJobContext(context.Background(), jobID, interval...)
+}
+
+// WaitForJobContext Same as WaitForJob, using ctx to stop waiting.
+func (j *jobs) WaitForJobContext(ctx context.Context, jobID string, interval ...time.Duration) (*models.Job, error) {
+	d := defaultJobPollInterval
+	if len(interval) > 0 && interval[0] > 0 {
+		d = interval[0]
+	}
+
+	for {
+		job, err := j.QueryJobContext(ctx, jobID)
+		if err != nil {
+			return nil, err
+		}
+
+		if job == nil {
+			return nil, ErrFailedUnmarshalResponseBody
+		}
+
+		if job.IsCompleted() {
+			if job.FinishCode != nil && *job.FinishCode != models.JobFinishCodeSuccess {
+				return job, newJobError(job)
+			}
+
+			return job, nil
+		}
+
+		if err = sleep(ctx, d); err != nil {
+			return nil, err
+		}
+	}
+}
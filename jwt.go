@@ -0,0 +1,66 @@
+package tableau
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+)
+
+type jwtHeader struct {
+	Algorithm string `json:"alg"`
+	Type      string `json:"typ"`
+	KeyID     string `json:"kid"`
+	Issuer    string `json:"iss"`
+}
+
+type jwtClaims struct {
+	Issuer    string   `json:"iss"`
+	Subject   string   `json:"sub"`
+	Audience  string   `json:"aud"`
+	ID        string   `json:"jti"`
+	ExpiresAt int64    `json:"exp"`
+	Scopes    []string `json:"scp"`
+}
+
+// newToken create a new signed JSON Web Token for Tableau connected app.
+//
+// Reference: https://help.tableau.com/current/online/en-us/connected_apps_direct.htm#step-3-configure-the-jwt
+func (a *ConnectedApp) newToken() (string, error) {
+	jti := make([]byte, 16)
+	if _, err := rand.Read(jti); err != nil {
+		return "", err
+	}
+
+	header, err := json.Marshal(jwtHeader{
+		Algorithm: jwtAlgorithm,
+		Type:      jwtType,
+		KeyID:     a.SecretID,
+		Issuer:    a.ClientID,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	claims, err := json.Marshal(jwtClaims{
+		Issuer:    a.ClientID,
+		Subject:   a.Username,
+		Audience:  jwtAudience,
+		ID:        hex.EncodeToString(jti),
+		ExpiresAt: time.Now().Add(a.Expiry).Unix(),
+		Scopes:    a.Scopes,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	enc := base64.RawURLEncoding
+	unsigned := enc.EncodeToString(header) + "." + enc.EncodeToString(claims)
+
+	mac := hmac.New(sha256.New, []byte(a.SecretValue))
+	mac.Write([]byte(unsigned))
+
+	return unsigned + "." + enc.EncodeToString(mac.Sum(nil)), nil
+}
@@ -0,0 +1,236 @@
+package tableau
+
+import (
+	"context"
+	"github.com/tiketdatarisal/tableau/models"
+)
+
+// lineageItem is an item returned by lineage queries.
+type lineageItem struct {
+	Typename string `json:"__typename,omitempty"`
+	ID       string `json:"id,omitempty"`
+	Luid     string `json:"luid,omitempty"`
+	Name     string `json:"name,omitempty"`
+}
+
+// lineageSheet is a sheet returned by lineage queries, along with its upstream items or its workbook.
+type lineageSheet struct {
+	lineageItem
+	UpstreamColumns []struct {
+		lineageItem
+		Table *lineageItem `json:"table,omitempty"`
+	} `json:"upstreamColumns,omitempty"`
+	UpstreamTables []struct {
+		lineageItem
+		Database *lineageItem `json:"database,omitempty"`
+	} `json:"upstreamTables,omitempty"`
+	UpstreamDatabases []lineageItem `json:"upstreamDatabases,omitempty"`
+	Workbook          *lineageItem  `json:"workbook,omitempty"`
+}
+
+// GetTableDownstream Returns lineage graph of the sheets and workbooks reading from database tables having the specified name,
+// along with owners of the workbooks. An empty graph is returned when no table has the name.
+// Requires REST API version 3.5 or later.
+//
+// Reference: https://help.tableau.com/current/api/metadata_api/en-us/docs/meta_api_model.html
+func (m *metadata) GetTableDownstream(tableName string) (*models.LineageGraph, error) {
+	return m.GetTableDownstreamContext(context.Background(), tableName)
+}
+
+// GetTableDownstreamContext Same as GetTableDownstream, using ctx to cancel the request.
+func (m *metadata) GetTableDownstreamContext(ctx context.Context, tableName string) (*models.LineageGraph, error) {
+	if tableName == "" {
+		return nil, ErrBadRequest
+	}
+
+	resBody := struct {
+		DatabaseTables []struct {
+			lineageItem
+			Database            *lineageItem   `json:"database,omitempty"`
+			DownstreamSheets    []lineageSheet `json:"downstreamSheets,omitempty"`
+			DownstreamWorkbooks []struct {
+				lineageItem
+				Owner *lineageItem `json:"owner,omitempty"`
+			} `json:"downstreamWorkbooks,omitempty"`
+		} `json:"databaseTables,omitempty"`
+	}{}
+
+	if err := m.QueryContext(ctx, tableDownstreamQuery, map[string]any{"name": tableName}, &resBody); err != nil {
+		return nil, err
+	}
+
+	g := &models.LineageGraph{}
+	for _, table := range resBody.DatabaseTables {
+		g.AddNode(table.node(models.LineageNodeTypeDatabaseTable))
+		if table.Database != nil {
+			g.AddNode(table.Database.node(models.LineageNodeTypeDatabase))
+			g.AddEdge(newFeedsEdge(table.Database.ID, table.ID))
+		}
+
+		workbooks := map[string]bool{}
+		for _, sheet := range table.DownstreamSheets {
+			g.AddNode(sheet.node(models.LineageNodeTypeSheet))
+			g.AddEdge(newFeedsEdge(table.ID, sheet.ID))
+			if sheet.Workbook != nil {
+				g.AddEdge(newFeedsEdge(sheet.ID, sheet.Workbook.ID))
+				workbooks[sheet.Workbook.ID] = true
+			}
+		}
+
+		for _, workbook := range table.DownstreamWorkbooks {
+			g.AddNode(workbook.node(models.LineageNodeTypeWorkbook))
+			if !workbooks[workbook.ID] {
+				g.AddEdge(newFeedsEdge(table.ID, workbook.ID))
+			}
+
+			if workbook.Owner != nil {
+				g.AddNode(workbook.Owner.node(models.LineageNodeTypeUser))
+				g.AddEdge(models.LineageEdge{From: workbook.Owner.ID, To: workbook.ID, Type: models.LineageEdgeTypeOwns})
+			}
+		}
+	}
+
+	return g, nil
+}
+
+// GetViewUpstream Returns lineage graph of the databases, tables and columns used by the specified view,
+// either a sheet or a dashboard. viewID is the view ID returned by WorkbooksViews methods.
+// Requires REST API version 3.5 or later.
+//
+// Reference: https://help.tableau.com/current/api/metadata_api/en-us/docs/meta_api_model.html
+func (m *metadata) GetViewUpstream(viewID string) (*models.LineageGraph, error) {
+	return m.GetViewUpstreamContext(context.Background(), viewID)
+}
+
+// GetViewUpstreamContext Same as GetViewUpstream, using ctx to cancel the request.
+func (m *metadata) GetViewUpstreamContext(ctx context.Context, viewID string) (*models.LineageGraph, error) {
+	if viewID == "" {
+		return nil, ErrBadRequest
+	}
+
+	resBody := struct {
+		Sheets     []lineageSheet `json:"sheets,omitempty"`
+		Dashboards []struct {
+			lineageItem
+			Sheets []lineageSheet `json:"sheets,omitempty"`
+		} `json:"dashboards,omitempty"`
+	}{}
+
+	if err := m.QueryContext(ctx, viewUpstreamQuery, map[string]any{"luid": viewID}, &resBody); err != nil {
+		return nil, err
+	}
+
+	if len(resBody.Sheets) == 0 && len(resBody.Dashboards) == 0 {
+		return nil, ErrViewNotFound
+	}
+
+	g := &models.LineageGraph{}
+	for _, sheet := range resBody.Sheets {
+		addSheetUpstream(g, sheet, "")
+	}
+
+	for _, dashboard := range resBody.Dashboards {
+		g.AddNode(dashboard.node(models.LineageNodeTypeDashboard))
+		for _, sheet := range dashboard.Sheets {
+			addSheetUpstream(g, sheet, dashboard.ID)
+		}
+	}
+
+	return g, nil
+}
+
+// GetWorkbookUpstream Returns lineage graph of the databases, tables and columns used by sheets of the specified workbook.
+// workbookID is the workbook ID returned by WorkbooksViews methods.
+// Requires REST API version 3.5 or later.
+//
+// Reference: https://help.tableau.com/current/api/metadata_api/en-us/docs/meta_api_model.html
+func (m *metadata) GetWorkbookUpstream(workbookID string) (*models.LineageGraph, error) {
+	return m.GetWorkbookUpstreamContext(context.Background(), workbookID)
+}
+
+// GetWorkbookUpstreamContext Same as GetWorkbookUpstream, using ctx to cancel the request.
+func (m *metadata) GetWorkbookUpstreamContext(ctx context.Context, workbookID string) (*models.LineageGraph, error) {
+	if workbookID == "" {
+		return nil, ErrBadRequest
+	}
+
+	resBody := struct {
+		Workbooks []struct {
+			lineageItem
+			Sheets []lineageSheet `json:"sheets,omitempty"`
+		} `json:"workbooks,omitempty"`
+	}{}
+
+	if err := m.QueryContext(ctx, workbookUpstreamQuery, map[string]any{"luid": workbookID}, &resBody); err != nil {
+		return nil, err
+	}
+
+	if len(resBody.Workbooks) == 0 {
+		return nil, ErrWorkbookNotFound
+	}
+
+	g := &models.LineageGraph{}
+	for _, workbook := range resBody.Workbooks {
+		g.AddNode(workbook.node(models.LineageNodeTypeWorkbook))
+		for _, sheet := range workbook.Sheets {
+			addSheetUpstream(g, sheet, workbook.ID)
+		}
+	}
+
+	return g, nil
+}
+
+// node return the lineage node of the item, using its GraphQL type name when returned, otherwise typ.
+func (i *lineageItem) node(typ string) models.LineageNode {
+	if i.Typename != "" {
+		typ = i.Typename
+	}
+
+	return models.LineageNode{ID: i.ID, Luid: i.Luid, Name: i.Name, Type: typ}
+}
+
+// addSheetUpstream adds sheet and its upstream items into g, connecting the sheet to downstreamID when it is not empty.
+// Tables are connected to the sheet through the columns used by the sheet, and databases through their tables,
+// or directly when none is returned.
+func addSheetUpstream(g *models.LineageGraph, sheet lineageSheet, downstreamID string) {
+	g.AddNode(sheet.node(models.LineageNodeTypeSheet))
+	if downstreamID != "" {
+		g.AddEdge(newFeedsEdge(sheet.ID, downstreamID))
+	}
+
+	tables, databases := map[string]bool{}, map[string]bool{}
+	for _, column := range sheet.UpstreamColumns {
+		g.AddNode(column.node(models.LineageNodeTypeColumn))
+		g.AddEdge(newFeedsEdge(column.ID, sheet.ID))
+		if column.Table != nil {
+			g.AddNode(column.Table.node(models.LineageNodeTypeDatabaseTable))
+			g.AddEdge(newFeedsEdge(column.Table.ID, column.ID))
+			tables[column.Table.ID] = true
+		}
+	}
+
+	for _, table := range sheet.UpstreamTables {
+		g.AddNode(table.node(models.LineageNodeTypeDatabaseTable))
+		if !tables[table.ID] {
+			g.AddEdge(newFeedsEdge(table.ID, sheet.ID))
+		}
+
+		if table.Database != nil {
+			g.AddNode(table.Database.node(models.LineageNodeTypeDatabase))
+			g.AddEdge(newFeedsEdge(table.Database.ID, table.ID))
+			databases[table.Database.ID] = true
+		}
+	}
+
+	for _, database := range sheet.UpstreamDatabases {
+		g.AddNode(database.node(models.LineageNodeTypeDatabase))
+		if !databases[database.ID] {
+			g.AddEdge(newFeedsEdge(database.ID, sheet.ID))
+		}
+	}
+}
+
+// newFeedsEdge return edge of data flowing from upstream item to downstream item.
+func newFeedsEdge(from, to string) models.LineageEdge {
+	return models.LineageEdge{From: from, To: to, Type: models.LineageEdgeTypeFeeds}
+}
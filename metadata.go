@@ -0,0 +1,159 @@
+package tableau
+
+import (
+	"context"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/tiketdatarisal/tableau/models"
+	"net/http"
+	"strings"
+)
+
+type metadata struct {
+	base *Client
+}
+
+// Query Sends a GraphQL query with its variables to the Metadata API using the current session,
+// and decodes data of the response into v. When the response contains GraphQL errors,
+// the partial data is still decoded into v, and *GraphQLError holding the errors is returned.
+// Requires REST API version 3.5 or later.
+//
+// URI:
+//
+//	POST /api/metadata/graphql
+//
+// Reference: https://help.tableau.com/current/api/metadata_api/en-us/docs/meta_api_start.html
+func (m *metadata) Query(query string, variables map[string]any, v any) error {
+	return m.QueryContext(context.Background(), query, variables, v)
+}
+
+// QueryContext Same as Query, using ctx to cancel the request.
+func (m *metadata) QueryContext(ctx context.Context, query string, variables map[string]any, v any) error {
+	data, err := m.query(ctx, query, variables)
+	if len(data) > 0 && v != nil {
+		if unmarshalErr := json.Unmarshal(data, v); unmarshalErr != nil {
+			return ErrFailedUnmarshalResponseBody
+		}
+	}
+
+	return err
+}
+
+// QueryMetadataConnection Sends a GraphQL query to the Metadata API page by page, following Relay cursor pagination
+// of the connection, and returns nodes of all pages decoded into T. connection is the path of the connection field
+// in the response data separated by dots, such as "workbooksConnection". The query must declare an $after variable
+// of type String, pass it as the after argument of the connection, and select nodes and pageInfo of the connection.
+// No more pages are requested after ctx is done.
+//
+//	workbooks, err := tableau.QueryMetadataConnection[Workbook](ctx, client, `query($after: String) {
+//		workbooksConnection(first: 100, after: $after) {
+//			nodes { luid name }
+//			pageInfo { hasNextPage endCursor }
+//		}
+//	}`, nil, "workbooksConnection")
+func QueryMetadataConnection[T any](ctx context.Context, c *Client, query string, variables map[string]any, connection string) ([]T, error) {
+	vars := make(map[string]any, len(variables)+1)
+	for k, v := range variables {
+		vars[k] = v
+	}
+
+	var result []T
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		data, err := c.Metadata.query(ctx, query, vars)
+		if err != nil {
+			return nil, err
+		}
+
+		conn := struct {
+			Nodes    []T              `json:"nodes,omitempty"`
+			PageInfo *models.PageInfo `json:"pageInfo,omitempty"`
+		}{}
+
+		if err = unmarshalPath(data, connection, &conn); err != nil {
+			return nil, err
+		}
+
+		result = append(result, conn.Nodes...)
+		if conn.PageInfo == nil || conn.PageInfo.HasNextPage == nil || !*conn.PageInfo.HasNextPage {
+			return result, nil
+		}
+
+		if conn.PageInfo.EndCursor == nil || *conn.PageInfo.EndCursor == "" || vars[metadataCursorVariable] == *conn.PageInfo.EndCursor {
+			return nil, ErrFailedUnmarshalResponseBody
+		}
+
+		vars[metadataCursorVariable] = *conn.PageInfo.EndCursor
+	}
+}
+
+// query sends query to the Metadata API, and returns undecoded data of the response along with GraphQL errors if any.
+func (m *metadata) query(ctx context.Context, query string, variables map[string]any) (jsoniter.RawMessage, error) {
+	if err := m.base.requireVersion("Metadata API", minVersionMetadata); err != nil {
+		return nil, err
+	}
+
+	if !m.base.Authentication.IsSignedIn() {
+		if err := m.base.Authentication.SignInContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if strings.TrimSpace(query) == "" {
+		return nil, ErrBadRequest
+	}
+
+	reqBody := models.GraphQLRequest{
+		Query:     query,
+		Variables: variables,
+	}
+
+	url := m.base.cfg.getHostUrl(metadataGraphQLUri)
+	if url == "" {
+		return nil, ErrInvalidHost
+	}
+
+	req := m.base.newRequest(ctx, mimeTypeJSON).SetBody(reqBody)
+	res, err := m.base.execute(req, http.MethodPost, url, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody := models.GraphQLResponse{}
+	if err = json.Unmarshal(res.Body(), &resBody); err != nil {
+		return nil, ErrFailedUnmarshalResponseBody
+	}
+
+	if len(resBody.Errors) > 0 {
+		return resBody.Data, &GraphQLError{Errors: resBody.Errors}
+	}
+
+	return resBody.Data, nil
+}
+
+// unmarshalPath decodes the field of data at path separated by dots into v, or data itself when path is empty.
+func unmarshalPath(data jsoniter.RawMessage, path string, v any) error {
+	if path != "" {
+		for _, key := range strings.Split(path, ".") {
+			fields := map[string]jsoniter.RawMessage{}
+			if err := json.Unmarshal(data, &fields); err != nil {
+				return ErrFailedUnmarshalResponseBody
+			}
+
+			field, ok := fields[key]
+			if !ok {
+				return ErrFailedUnmarshalResponseBody
+			}
+
+			data = field
+		}
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return ErrFailedUnmarshalResponseBody
+	}
+
+	return nil
+}
@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+type BackgroundJob struct {
+	ID        *string    `json:"id,omitempty"`
+	Status    *string    `json:"status,omitempty"`
+	JobType   *string    `json:"jobType,omitempty"`
+	Priority  *string    `json:"priority,omitempty"`
+	Title     *string    `json:"title,omitempty"`
+	Subtitle  *string    `json:"subtitle,omitempty"`
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	StartedAt *time.Time `json:"startedAt,omitempty"`
+	EndedAt   *time.Time `json:"endedAt,omitempty"`
+}
@@ -0,0 +1,6 @@
+package models
+
+type Capability struct {
+	Name string `json:"name,omitempty"`
+	Mode string `json:"mode,omitempty"`
+}
@@ -0,0 +1,14 @@
+package models
+
+type Connection struct {
+	ID                  *string                `json:"id,omitempty"`
+	Type                *string                `json:"type,omitempty"`
+	ServerAddress       *string                `json:"serverAddress,omitempty"`
+	ServerPort          *string                `json:"serverPort,omitempty"`
+	UserName            *string                `json:"userName,omitempty"`
+	Password            *string                `json:"password,omitempty"`
+	EmbedPassword       *bool                  `json:"embedPassword,omitempty"`
+	QueryTaggingEnabled *bool                  `json:"queryTaggingEnabled,omitempty"`
+	Credentials         *ConnectionCredentials `json:"connectionCredentials,omitempty"`
+	DataSource          *DataSource            `json:"datasource,omitempty"`
+}
@@ -0,0 +1,5 @@
+package models
+
+type ConnectionBody struct {
+	Connection *Connection `json:"connection,omitempty"`
+}
@@ -0,0 +1,9 @@
+package models
+
+// ConnectionCredentials holds credentials embedded into a connection when publishing content.
+type ConnectionCredentials struct {
+	Name     *string `json:"name,omitempty"`
+	Password *string `json:"password,omitempty"`
+	Embed    *bool   `json:"embed,omitempty"`
+	OAuth    *bool   `json:"oAuth,omitempty"`
+}
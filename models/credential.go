@@ -1,9 +1,40 @@
 package models
 
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
 type Credentials struct {
-	Name     string `json:"name,omitempty"`
-	Password string `json:"password,omitempty"`
-	Site     *Site  `json:"site,omitempty"`
-	User     *User  `json:"user,omitempty"`
-	Token    string `json:"token,omitempty"`
+	Name                      string `json:"name,omitempty"`
+	Password                  string `json:"password,omitempty"`
+	PersonalAccessTokenName   string `json:"personalAccessTokenName,omitempty"`
+	PersonalAccessTokenSecret string `json:"personalAccessTokenSecret,omitempty"`
+	JWT                       string `json:"jwt,omitempty"`
+	Site                      *Site  `json:"site,omitempty"`
+	User                      *User  `json:"user,omitempty"`
+	Token                     string `json:"token,omitempty"`
+	EstimatedTimeToExpiration string `json:"estimatedTimeToExpiration,omitempty"`
+}
+
+// GetEstimatedTimeToExpiration return estimated time to expiration in hours:minutes:seconds format as duration.
+// Zero is returned when the value is empty or not valid.
+func (c Credentials) GetEstimatedTimeToExpiration() time.Duration {
+	parts := strings.Split(c.EstimatedTimeToExpiration, ":")
+	if len(parts) != 3 {
+		return 0
+	}
+
+	var d time.Duration
+	for i, unit := range []time.Duration{time.Hour, time.Minute, time.Second} {
+		n, err := strconv.Atoi(parts[i])
+		if err != nil || n < 0 {
+			return 0
+		}
+
+		d += time.Duration(n) * unit
+	}
+
+	return d
 }
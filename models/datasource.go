@@ -0,0 +1,25 @@
+package models
+
+import "time"
+
+type DataSource struct {
+	ID                  *string    `json:"id,omitempty"`
+	Name                *string    `json:"name,omitempty"`
+	Description         *string    `json:"description,omitempty"`
+	ContentUrl          *string    `json:"contentUrl,omitempty"`
+	WebpageUrl          *string    `json:"webpageUrl,omitempty"`
+	Type                *string    `json:"type,omitempty"`
+	Size                *string    `json:"size,omitempty"`
+	CreatedAt           *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt           *time.Time `json:"updatedAt,omitempty"`
+	EncryptExtracts     *string    `json:"encryptExtracts,omitempty"`
+	HasExtracts         *bool      `json:"hasExtracts,omitempty"`
+	IsCertified         *bool      `json:"isCertified,omitempty"`
+	CertificationNote   *string    `json:"certificationNote,omitempty"`
+	UseRemoteQueryAgent *bool      `json:"useRemoteQueryAgent,omitempty"`
+	Project             *Project   `json:"project,omitempty"`
+	Owner               *Owner     `json:"owner,omitempty"`
+	Tags                *struct {
+		Tag []Tag `json:"tag,omitempty"`
+	} `json:"tags,omitempty"`
+}
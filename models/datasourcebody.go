@@ -0,0 +1,5 @@
+package models
+
+type DataSourceBody struct {
+	DataSource *DataSource `json:"datasource,omitempty"`
+}
@@ -0,0 +1,11 @@
+package models
+
+type ExtractRefresh struct {
+	ID                     *string     `json:"id,omitempty"`
+	Priority               *string     `json:"priority,omitempty"`
+	ConsecutiveFailedCount *string     `json:"consecutiveFailedCount,omitempty"`
+	Type                   *string     `json:"type,omitempty"`
+	Schedule               *Schedule   `json:"schedule,omitempty"`
+	Workbook               *Workbook   `json:"workbook,omitempty"`
+	DataSource             *DataSource `json:"datasource,omitempty"`
+}
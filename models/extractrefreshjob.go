@@ -0,0 +1,7 @@
+package models
+
+type ExtractRefreshJob struct {
+	Notes      *string     `json:"notes,omitempty"`
+	Workbook   *Workbook   `json:"workbook,omitempty"`
+	DataSource *DataSource `json:"datasource,omitempty"`
+}
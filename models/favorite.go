@@ -0,0 +1,13 @@
+package models
+
+import "time"
+
+type Favorite struct {
+	Label      *string     `json:"label,omitempty"`
+	Position   *string     `json:"position,omitempty"`
+	AddedAt    *time.Time  `json:"addedAt,omitempty"`
+	Workbook   *Workbook   `json:"workbook,omitempty"`
+	View       *View       `json:"view,omitempty"`
+	DataSource *DataSource `json:"datasource,omitempty"`
+	Project    *Project    `json:"project,omitempty"`
+}
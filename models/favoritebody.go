@@ -0,0 +1,5 @@
+package models
+
+type FavoriteBody struct {
+	Favorite *Favorite `json:"favorite,omitempty"`
+}
@@ -0,0 +1,10 @@
+package models
+
+// FavoriteOrdering moves the favorite identified by FavoriteID and FavoriteType after another favorite.
+// Favorite types are one of the FavoriteType* constants.
+type FavoriteOrdering struct {
+	FavoriteID            string `json:"favoriteId"`
+	FavoriteType          string `json:"favoriteType"`
+	FavoriteIDMoveAfter   string `json:"favoriteIdMoveAfter"`
+	FavoriteTypeMoveAfter string `json:"favoriteTypeMoveAfter"`
+}
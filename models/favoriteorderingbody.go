@@ -0,0 +1,7 @@
+package models
+
+type FavoriteOrderingBody struct {
+	FavoriteOrderings *struct {
+		FavoriteOrdering []FavoriteOrdering `json:"favoriteOrdering,omitempty"`
+	} `json:"favoriteOrderings,omitempty"`
+}
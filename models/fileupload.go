@@ -0,0 +1,6 @@
+package models
+
+type FileUpload struct {
+	UploadSessionID *string `json:"uploadSessionId,omitempty"`
+	FileSize        *string `json:"fileSize,omitempty"`
+}
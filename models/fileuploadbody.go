@@ -0,0 +1,5 @@
+package models
+
+type FileUploadBody struct {
+	FileUpload *FileUpload `json:"fileUpload,omitempty"`
+}
@@ -0,0 +1,11 @@
+package models
+
+// FrequencyDetails holds when a schedule runs.
+// Start and End are times of day formatted as HH:MM:SS, End is only used by hourly schedules.
+type FrequencyDetails struct {
+	Start     *string `json:"start,omitempty"`
+	End       *string `json:"end,omitempty"`
+	Intervals *struct {
+		Interval []Interval `json:"interval,omitempty"`
+	} `json:"intervals,omitempty"`
+}
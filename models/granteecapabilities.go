@@ -0,0 +1,12 @@
+package models
+
+// GranteeCapabilities holds capabilities granted to either a user or a group.
+type GranteeCapabilities struct {
+	Group        *Group        `json:"group,omitempty"`
+	User         *User         `json:"user,omitempty"`
+	Capabilities *Capabilities `json:"capabilities,omitempty"`
+}
+
+type Capabilities struct {
+	Capability []Capability `json:"capability,omitempty"`
+}
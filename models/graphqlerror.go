@@ -0,0 +1,12 @@
+package models
+
+// GraphQLError is an error returned by a GraphQL API, Path holds field names and list indexes of the failed field.
+type GraphQLError struct {
+	Message   string `json:"message,omitempty"`
+	Locations []struct {
+		Line   int `json:"line,omitempty"`
+		Column int `json:"column,omitempty"`
+	} `json:"locations,omitempty"`
+	Path       []any          `json:"path,omitempty"`
+	Extensions map[string]any `json:"extensions,omitempty"`
+}
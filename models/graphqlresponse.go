@@ -0,0 +1,9 @@
+package models
+
+import jsoniter "github.com/json-iterator/go"
+
+// GraphQLResponse holds data of a GraphQL response, left undecoded so it can be decoded into the caller struct.
+type GraphQLResponse struct {
+	Data   jsoniter.RawMessage `json:"data,omitempty"`
+	Errors []GraphQLError      `json:"errors,omitempty"`
+}
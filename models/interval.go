@@ -0,0 +1,10 @@
+package models
+
+// Interval holds a single interval of a schedule, only one of its fields is set.
+// Hours and Minutes are used by hourly schedules, WeekDay by daily and weekly schedules, and MonthDay by monthly schedules.
+type Interval struct {
+	Hours    *string `json:"hours,omitempty"`
+	Minutes  *string `json:"minutes,omitempty"`
+	WeekDay  *string `json:"weekDay,omitempty"`
+	MonthDay *string `json:"monthDay,omitempty"`
+}
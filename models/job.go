@@ -0,0 +1,23 @@
+package models
+
+import "time"
+
+type Job struct {
+	ID          *string    `json:"id,omitempty"`
+	Mode        *string    `json:"mode,omitempty"`
+	Type        *string    `json:"type,omitempty"`
+	Progress    *string    `json:"progress,omitempty"`
+	FinishCode  *string    `json:"finishCode,omitempty"`
+	CreatedAt   *time.Time `json:"createdAt,omitempty"`
+	StartedAt   *time.Time `json:"startedAt,omitempty"`
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+	StatusNotes *struct {
+		StatusNote []StatusNote `json:"statusNote,omitempty"`
+	} `json:"statusNotes,omitempty"`
+	ExtractRefreshJob *ExtractRefreshJob `json:"extractRefreshJob,omitempty"`
+}
+
+// IsCompleted return true when the job has finished, either succeeded, failed or cancelled.
+func (j Job) IsCompleted() bool {
+	return j.CompletedAt != nil
+}
@@ -0,0 +1,5 @@
+package models
+
+type JobBody struct {
+	Job *Job `json:"job,omitempty"`
+}
@@ -0,0 +1,9 @@
+package models
+
+// LineageEdge connects two nodes of a lineage graph by their IDs, from upstream to downstream for feeds edges,
+// or from the owner to the owned item for owns edges.
+type LineageEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	Type string `json:"type,omitempty"`
+}
@@ -0,0 +1,99 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// LineageGraph holds nodes and edges returned by lineage queries, and can be exported as DOT or JSON.
+type LineageGraph struct {
+	Nodes []LineageNode `json:"nodes"`
+	Edges []LineageEdge `json:"edges"`
+
+	nodes map[string]int
+	edges map[LineageEdge]struct{}
+}
+
+// AddNode adds node into the graph, nodes having the same ID as an existing node are ignored.
+func (g *LineageGraph) AddNode(node LineageNode) {
+	if g.nodes == nil {
+		g.nodes = map[string]int{}
+		for i, n := range g.Nodes {
+			g.nodes[n.ID] = i
+		}
+	}
+
+	if _, ok := g.nodes[node.ID]; ok || node.ID == "" {
+		return
+	}
+
+	g.nodes[node.ID] = len(g.Nodes)
+	g.Nodes = append(g.Nodes, node)
+}
+
+// AddEdge adds edge into the graph, duplicate edges are ignored.
+func (g *LineageGraph) AddEdge(edge LineageEdge) {
+	if g.edges == nil {
+		g.edges = map[LineageEdge]struct{}{}
+		for _, e := range g.Edges {
+			g.edges[e] = struct{}{}
+		}
+	}
+
+	if _, ok := g.edges[edge]; ok || edge.From == "" || edge.To == "" {
+		return
+	}
+
+	g.edges[edge] = struct{}{}
+	g.Edges = append(g.Edges, edge)
+}
+
+// Node return the node having the specified ID, or nil when it is not found.
+func (g *LineageGraph) Node(id string) *LineageNode {
+	for i := range g.Nodes {
+		if g.Nodes[i].ID == id {
+			return &g.Nodes[i]
+		}
+	}
+
+	return nil
+}
+
+// DOT return the graph in Graphviz DOT language, owns edges are drawn dashed.
+func (g *LineageGraph) DOT() string {
+	sb := strings.Builder{}
+	sb.WriteString("digraph lineage {\n\trankdir=LR;\n")
+
+	for _, n := range g.Nodes {
+		label := n.Name
+		if n.Type != "" {
+			label = label + "\n(" + n.Type + ")"
+		}
+
+		shape, ok := lineageNodeShapes[n.Type]
+		if !ok {
+			shape = "box"
+		}
+
+		sb.WriteString(fmt.Sprintf("\t%s [label=%s, shape=%s];\n", strconv.Quote(n.ID), strconv.Quote(label), shape))
+	}
+
+	for _, e := range g.Edges {
+		style := ""
+		if e.Type == LineageEdgeTypeOwns {
+			style = " [style=dashed]"
+		}
+
+		sb.WriteString(fmt.Sprintf("\t%s -> %s%s;\n", strconv.Quote(e.From), strconv.Quote(e.To), style))
+	}
+
+	sb.WriteString("}\n")
+
+	return sb.String()
+}
+
+// JSON return the graph encoded as JSON.
+func (g *LineageGraph) JSON() ([]byte, error) {
+	return json.Marshal(g)
+}
@@ -0,0 +1,9 @@
+package models
+
+// LineageNode is an item of a lineage graph, ID is the Metadata API ID and Luid is the REST API ID if any.
+type LineageNode struct {
+	ID   string `json:"id"`
+	Luid string `json:"luid,omitempty"`
+	Name string `json:"name,omitempty"`
+	Type string `json:"type,omitempty"`
+}
@@ -0,0 +1,7 @@
+package models
+
+// PageInfo holds Relay cursor pagination of a GraphQL connection.
+type PageInfo struct {
+	HasNextPage *bool   `json:"hasNextPage,omitempty"`
+	EndCursor   *string `json:"endCursor,omitempty"`
+}
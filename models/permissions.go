@@ -0,0 +1,9 @@
+package models
+
+type Permissions struct {
+	Project             *Project              `json:"project,omitempty"`
+	Workbook            *Workbook             `json:"workbook,omitempty"`
+	View                *View                 `json:"view,omitempty"`
+	DataSource          *DataSource           `json:"datasource,omitempty"`
+	GranteeCapabilities []GranteeCapabilities `json:"granteeCapabilities,omitempty"`
+}
@@ -0,0 +1,5 @@
+package models
+
+type PermissionsBody struct {
+	Permissions *Permissions `json:"permissions,omitempty"`
+}
@@ -0,0 +1,7 @@
+package models
+
+// ProductVersion holds the product version of the server, such as 2023.1.0, and its build number.
+type ProductVersion struct {
+	Value *string `json:"value,omitempty"`
+	Build *string `json:"build,omitempty"`
+}
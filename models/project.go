@@ -1,5 +1,15 @@
 package models
 
+import "time"
+
 type Project struct {
-	ID *string `json:"id,omitempty"`
+	ID                              *string    `json:"id,omitempty"`
+	Name                            *string    `json:"name,omitempty"`
+	Description                     *string    `json:"description,omitempty"`
+	ParentProjectID                 *string    `json:"parentProjectId,omitempty"`
+	ContentPermissions              *string    `json:"contentPermissions,omitempty"`
+	ControllingPermissionsProjectID *string    `json:"controllingPermissionsProjectId,omitempty"`
+	CreatedAt                       *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt                       *time.Time `json:"updatedAt,omitempty"`
+	Owner                           *Owner     `json:"owner,omitempty"`
 }
@@ -0,0 +1,5 @@
+package models
+
+type ProjectBody struct {
+	Project *Project `json:"project,omitempty"`
+}
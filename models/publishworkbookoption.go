@@ -0,0 +1,15 @@
+package models
+
+import "fmt"
+
+type PublishWorkbookOption struct {
+	Overwrite           bool
+	SkipConnectionCheck bool
+}
+
+func (o *PublishWorkbookOption) Encode() string {
+	return fmt.Sprintf("&overwrite=%t&skipConnectionCheck=%t",
+		o.Overwrite,
+		o.SkipConnectionCheck,
+	)
+}
@@ -0,0 +1,8 @@
+package models
+
+type QueryBackgroundJobBody struct {
+	Pagination     *Pagination `json:"pagination,omitempty"`
+	BackgroundJobs *struct {
+		BackgroundJob []BackgroundJob `json:"backgroundJob,omitempty"`
+	} `json:"backgroundJobs,omitempty"`
+}
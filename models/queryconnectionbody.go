@@ -0,0 +1,8 @@
+package models
+
+type QueryConnectionBody struct {
+	Pagination  *Pagination `json:"pagination,omitempty"`
+	Connections *struct {
+		Connection []Connection `json:"connection,omitempty"`
+	} `json:"connections,omitempty"`
+}
@@ -0,0 +1,8 @@
+package models
+
+type QueryDataSourceBody struct {
+	Pagination  *Pagination `json:"pagination,omitempty"`
+	DataSources *struct {
+		DataSource []DataSource `json:"datasource,omitempty"`
+	} `json:"datasources,omitempty"`
+}
@@ -0,0 +1,8 @@
+package models
+
+type QueryFavoriteBody struct {
+	Pagination *Pagination `json:"pagination,omitempty"`
+	Favorites  *struct {
+		Favorite []Favorite `json:"favorite,omitempty"`
+	} `json:"favorites,omitempty"`
+}
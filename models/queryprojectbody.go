@@ -0,0 +1,8 @@
+package models
+
+type QueryProjectBody struct {
+	Pagination *Pagination `json:"pagination,omitempty"`
+	Projects   *struct {
+		Project []Project `json:"project,omitempty"`
+	} `json:"projects,omitempty"`
+}
@@ -0,0 +1,8 @@
+package models
+
+type QueryRevisionBody struct {
+	Pagination *Pagination `json:"pagination,omitempty"`
+	Revisions  *struct {
+		Revision []Revision `json:"revision,omitempty"`
+	} `json:"revisions,omitempty"`
+}
@@ -0,0 +1,8 @@
+package models
+
+type QueryScheduleBody struct {
+	Pagination *Pagination `json:"pagination,omitempty"`
+	Schedules  *struct {
+		Schedule []Schedule `json:"schedule,omitempty"`
+	} `json:"schedules,omitempty"`
+}
@@ -0,0 +1,8 @@
+package models
+
+type QuerySiteBody struct {
+	Pagination *Pagination `json:"pagination,omitempty"`
+	Sites      *struct {
+		Site []Site `json:"site,omitempty"`
+	} `json:"sites,omitempty"`
+}
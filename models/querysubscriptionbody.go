@@ -0,0 +1,8 @@
+package models
+
+type QuerySubscriptionBody struct {
+	Pagination    *Pagination `json:"pagination,omitempty"`
+	Subscriptions *struct {
+		Subscription []Subscription `json:"subscription,omitempty"`
+	} `json:"subscriptions,omitempty"`
+}
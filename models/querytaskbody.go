@@ -0,0 +1,7 @@
+package models
+
+type QueryTaskBody struct {
+	Tasks *struct {
+		Task []Task `json:"task,omitempty"`
+	} `json:"tasks,omitempty"`
+}
@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+type Revision struct {
+	RevisionNumber *string    `json:"revisionNumber,omitempty"`
+	PublishedAt    *time.Time `json:"publishedAt,omitempty"`
+	Deleted        *bool      `json:"deleted,omitempty"`
+	Current        *bool      `json:"current,omitempty"`
+	SizeInBytes    *string    `json:"sizeInBytes,omitempty"`
+	Publisher      *User      `json:"publisher,omitempty"`
+}
@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+type Schedule struct {
+	ID               *string           `json:"id,omitempty"`
+	Name             *string           `json:"name,omitempty"`
+	State            *string           `json:"state,omitempty"`
+	Priority         *string           `json:"priority,omitempty"`
+	Type             *string           `json:"type,omitempty"`
+	Frequency        *string           `json:"frequency,omitempty"`
+	ExecutionOrder   *string           `json:"executionOrder,omitempty"`
+	CreatedAt        *time.Time        `json:"createdAt,omitempty"`
+	UpdatedAt        *time.Time        `json:"updatedAt,omitempty"`
+	NextRunAt        *time.Time        `json:"nextRunAt,omitempty"`
+	EndScheduleAt    *time.Time        `json:"endScheduleAt,omitempty"`
+	FrequencyDetails *FrequencyDetails `json:"frequencyDetails,omitempty"`
+}
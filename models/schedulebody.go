@@ -0,0 +1,5 @@
+package models
+
+type ScheduleBody struct {
+	Schedule *Schedule `json:"schedule,omitempty"`
+}
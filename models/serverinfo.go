@@ -0,0 +1,7 @@
+package models
+
+// ServerInfo holds the product version and build of the server, and the highest REST API version it supports.
+type ServerInfo struct {
+	ProductVersion *ProductVersion `json:"productVersion,omitempty"`
+	RestApiVersion *string         `json:"restApiVersion,omitempty"`
+}
@@ -0,0 +1,5 @@
+package models
+
+type ServerInfoBody struct {
+	ServerInfo *ServerInfo `json:"serverInfo,omitempty"`
+}
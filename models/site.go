@@ -1,6 +1,24 @@
 package models
 
 type Site struct {
-	ID         *string `json:"id,omitempty"`
-	ContentUrl *string `json:"contentUrl,omitempty"`
+	ID                           *string    `json:"id,omitempty"`
+	Name                         *string    `json:"name,omitempty"`
+	ContentUrl                   *string    `json:"contentUrl,omitempty"`
+	AdminMode                    *string    `json:"adminMode,omitempty"`
+	State                        *string    `json:"state,omitempty"`
+	UserQuota                    *string    `json:"userQuota,omitempty"`
+	StorageQuota                 *string    `json:"storageQuota,omitempty"`
+	TierCreatorCapacity          *string    `json:"tierCreatorCapacity,omitempty"`
+	TierExplorerCapacity         *string    `json:"tierExplorerCapacity,omitempty"`
+	TierViewerCapacity           *string    `json:"tierViewerCapacity,omitempty"`
+	DisableSubscriptions         *bool      `json:"disableSubscriptions,omitempty"`
+	SubscribeOthersEnabled       *bool      `json:"subscribeOthersEnabled,omitempty"`
+	AllowSubscriptionAttachments *bool      `json:"allowSubscriptionAttachments,omitempty"`
+	RevisionHistoryEnabled       *bool      `json:"revisionHistoryEnabled,omitempty"`
+	RevisionLimit                *string    `json:"revisionLimit,omitempty"`
+	GuestAccessEnabled           *bool      `json:"guestAccessEnabled,omitempty"`
+	CommentingEnabled            *bool      `json:"commentingEnabled,omitempty"`
+	CacheWarmupEnabled           *bool      `json:"cacheWarmupEnabled,omitempty"`
+	ExtractEncryptionMode        *string    `json:"extractEncryptionMode,omitempty"`
+	Usage                        *SiteUsage `json:"usage,omitempty"`
 }
@@ -0,0 +1,5 @@
+package models
+
+type SiteBody struct {
+	Site *Site `json:"site,omitempty"`
+}
@@ -0,0 +1,7 @@
+package models
+
+// SiteUsage holds number of users and storage used by a site, storage is in megabytes.
+type SiteUsage struct {
+	NumUsers *string `json:"numUsers,omitempty"`
+	Storage  *string `json:"storage,omitempty"`
+}
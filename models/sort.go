@@ -0,0 +1,27 @@
+package models
+
+import "strings"
+
+// Sort holds sort expressions, applied in order.
+type Sort []SortBy
+
+type SortBy struct {
+	Field      string
+	Descending bool
+}
+
+func (s Sort) String() string {
+	const comma = ","
+	var pairs []string
+
+	for _, by := range s {
+		direction := "asc"
+		if by.Descending {
+			direction = "desc"
+		}
+
+		pairs = append(pairs, by.Field+":"+direction)
+	}
+
+	return strings.Join(pairs, comma)
+}
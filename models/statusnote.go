@@ -0,0 +1,7 @@
+package models
+
+type StatusNote struct {
+	Type  *string `json:"type,omitempty"`
+	Value *string `json:"value,omitempty"`
+	Text  *string `json:"text,omitempty"`
+}
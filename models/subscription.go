@@ -0,0 +1,15 @@
+package models
+
+type Subscription struct {
+	ID              *string              `json:"id,omitempty"`
+	Subject         *string              `json:"subject,omitempty"`
+	Message         *string              `json:"message,omitempty"`
+	AttachImage     *bool                `json:"attachImage,omitempty"`
+	AttachPdf       *bool                `json:"attachPdf,omitempty"`
+	PageOrientation *string              `json:"pageOrientation,omitempty"`
+	PageSizeOption  *string              `json:"pageSizeOption,omitempty"`
+	Suspended       *bool                `json:"suspended,omitempty"`
+	Content         *SubscriptionContent `json:"content,omitempty"`
+	Schedule        *Schedule            `json:"schedule,omitempty"`
+	User            *User                `json:"user,omitempty"`
+}
@@ -0,0 +1,5 @@
+package models
+
+type SubscriptionBody struct {
+	Subscription *Subscription `json:"subscription,omitempty"`
+}
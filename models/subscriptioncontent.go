@@ -0,0 +1,8 @@
+package models
+
+// SubscriptionContent holds the view or workbook sent by a subscription.
+type SubscriptionContent struct {
+	ID              *string `json:"id,omitempty"`
+	Type            *string `json:"type,omitempty"`
+	SendIfViewEmpty *bool   `json:"sendIfViewEmpty,omitempty"`
+}
@@ -0,0 +1,5 @@
+package models
+
+type Task struct {
+	ExtractRefresh *ExtractRefresh `json:"extractRefresh,omitempty"`
+}
@@ -0,0 +1,5 @@
+package models
+
+type TaskBody struct {
+	Task *Task `json:"task,omitempty"`
+}
@@ -13,10 +13,140 @@ const (
 
 	ImageResolutionHigh = `high`
 
+	ContentPermissionsLockedToProject              = `LockedToProject`
+	ContentPermissionsLockedToProjectWithoutNested = `LockedToProjectWithoutNested`
+	ContentPermissionsManagedByOwner               = `ManagedByOwner`
+
+	CapabilityModeAllow = `Allow`
+	CapabilityModeDeny  = `Deny`
+
+	CapabilityAddComment             = `AddComment`
+	CapabilityChangeHierarchy        = `ChangeHierarchy`
+	CapabilityChangePermissions      = `ChangePermissions`
+	CapabilityConnect                = `Connect`
+	CapabilityCreateRefreshMetrics   = `CreateRefreshMetrics`
+	CapabilityDelete                 = `Delete`
+	CapabilityExportData             = `ExportData`
+	CapabilityExportImage            = `ExportImage`
+	CapabilityExportXml              = `ExportXml`
+	CapabilityExtractRefresh         = `ExtractRefresh`
+	CapabilityFilter                 = `Filter`
+	CapabilityInheritedProjectLeader = `InheritedProjectLeader`
+	CapabilityProjectLeader          = `ProjectLeader`
+	CapabilityRead                   = `Read`
+	CapabilityRunExplainData         = `RunExplainData`
+	CapabilitySaveAs                 = `SaveAs`
+	CapabilityShareView              = `ShareView`
+	CapabilityViewComments           = `ViewComments`
+	CapabilityViewUnderlyingData     = `ViewUnderlyingData`
+	CapabilityWebAuthoring           = `WebAuthoring`
+	CapabilityWrite                  = `Write`
+
+	DefaultPermissionsWorkbooks          = `workbooks`
+	DefaultPermissionsDataSources        = `datasources`
+	DefaultPermissionsFlows              = `flows`
+	DefaultPermissionsMetrics            = `metrics`
+	DefaultPermissionsLenses             = `lenses`
+	DefaultPermissionsDataRoles          = `dataroles`
+	DefaultPermissionsVirtualConnections = `virtualconnections`
+	DefaultPermissionsDatabases          = `databases`
+	DefaultPermissionsTables             = `tables`
+
+	JobFinishCodeSuccess   = `0`
+	JobFinishCodeFailed    = `1`
+	JobFinishCodeCancelled = `2`
+
+	JobStatusPending    = `Pending`
+	JobStatusInProgress = `InProgress`
+	JobStatusSuccess    = `Success`
+	JobStatusFailed     = `Failed`
+	JobStatusCancelled  = `Cancelled`
+
+	ScheduleTypeExtract          = `Extract`
+	ScheduleTypeFlow             = `Flow`
+	ScheduleTypeSubscription     = `Subscription`
+	ScheduleTypeDataAcceleration = `DataAcceleration`
+
+	ScheduleStateActive    = `Active`
+	ScheduleStateSuspended = `Suspended`
+
+	ScheduleFrequencyHourly  = `Hourly`
+	ScheduleFrequencyDaily   = `Daily`
+	ScheduleFrequencyWeekly  = `Weekly`
+	ScheduleFrequencyMonthly = `Monthly`
+
+	ScheduleExecutionOrderParallel = `Parallel`
+	ScheduleExecutionOrderSerial   = `Serial`
+
+	WeekDaySunday    = `Sunday`
+	WeekDayMonday    = `Monday`
+	WeekDayTuesday   = `Tuesday`
+	WeekDayWednesday = `Wednesday`
+	WeekDayThursday  = `Thursday`
+	WeekDayFriday    = `Friday`
+	WeekDaySaturday  = `Saturday`
+
+	SubscriptionContentTypeView     = `View`
+	SubscriptionContentTypeWorkbook = `Workbook`
+
+	PageOrientationPortrait  = `Portrait`
+	PageOrientationLandscape = `Landscape`
+
+	PageSizeA3          = `A3`
+	PageSizeA4          = `A4`
+	PageSizeA5          = `A5`
+	PageSizeB4          = `B4`
+	PageSizeB5          = `B5`
+	PageSizeExecutive   = `Executive`
+	PageSizeFolio       = `Folio`
+	PageSizeLedger      = `Ledger`
+	PageSizeLegal       = `Legal`
+	PageSizeLetter      = `Letter`
+	PageSizeNote        = `Note`
+	PageSizeQuarto      = `Quarto`
+	PageSizeTabloid     = `Tabloid`
+	PageSizeUnspecified = `Unspecified`
+
+	FavoriteTypeWorkbook   = `workbook`
+	FavoriteTypeView       = `view`
+	FavoriteTypeDataSource = `datasource`
+	FavoriteTypeProject    = `project`
+
+	SiteAdminModeContentAndUsers = `ContentAndUsers`
+	SiteAdminModeContentOnly     = `ContentOnly`
+
+	SiteStateActive    = `Active`
+	SiteStateSuspended = `Suspended`
+
+	ExtractEncryptionModeEnforced = `enforced`
+	ExtractEncryptionModeEnabled  = `enabled`
+	ExtractEncryptionModeDisabled = `disabled`
+
+	LineageNodeTypeDatabase      = `Database`
+	LineageNodeTypeDatabaseTable = `DatabaseTable`
+	LineageNodeTypeColumn        = `Column`
+	LineageNodeTypeSheet         = `Sheet`
+	LineageNodeTypeDashboard     = `Dashboard`
+	LineageNodeTypeWorkbook      = `Workbook`
+	LineageNodeTypeUser          = `TableauUser`
+
+	LineageEdgeTypeFeeds = `feeds`
+	LineageEdgeTypeOwns  = `owns`
+
 	defaultMaxAge = 60
 	minMaxAge     = 1
 )
 
 var (
 	json = jsoniter.ConfigCompatibleWithStandardLibrary
+
+	lineageNodeShapes = map[string]string{
+		LineageNodeTypeDatabase:      "cylinder",
+		LineageNodeTypeDatabaseTable: "box",
+		LineageNodeTypeColumn:        "ellipse",
+		LineageNodeTypeSheet:         "note",
+		LineageNodeTypeDashboard:     "tab",
+		LineageNodeTypeWorkbook:      "folder",
+		LineageNodeTypeUser:          "oval",
+	}
 )
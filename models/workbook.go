@@ -23,4 +23,7 @@ type Workbook struct {
 	Views *struct {
 		View []View `json:"view,omitempty"`
 	} `json:"views,omitempty"`
+	Connections *struct {
+		Connection []Connection `json:"connection,omitempty"`
+	} `json:"connections,omitempty"`
 }
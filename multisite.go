@@ -0,0 +1,187 @@
+package tableau
+
+import (
+	"context"
+	"github.com/go-resty/resty/v2"
+	"sync"
+)
+
+// MultiSiteClient holds one client per site, each signed in to its own site using the same credentials,
+// so operations on one site do not invalidate the sessions of other sites.
+// Personal access tokens only allow one session at a time, so use username and password or a connected app instead.
+//
+// MultiSiteClient is safe for concurrent use by multiple goroutines.
+type MultiSiteClient struct {
+	c       *resty.Client
+	cfg     *Config
+	mu      sync.Mutex
+	clients map[string]*Client
+
+	// MaxConcurrency limits the number of sites FanOut runs at the same time. Default is 4.
+	MaxConcurrency int
+}
+
+// SiteResult holds the result of a function run by FanOut on a site.
+type SiteResult[T any] struct {
+	ContentUrl string
+	Result     T
+	Err        error
+}
+
+// NewMultiSiteClient Initialize a new Tableau client for multiple sites.
+// ContentUrl of cfg is the site used to list the sites accessible by the user.
+// When DetectVersion of cfg is set, the REST API version is detected once and used by all sites.
+func NewMultiSiteClient(cfg Config) (*MultiSiteClient, error) {
+	if err := cfg.initConfig(); err != nil {
+		return nil, err
+	}
+
+	// NOTE: Clients of all sites share the rest client, so session cookies of one site must not be sent to other sites
+	restClient := newRestClient().SetCookieJar(nil)
+	if cfg.DetectVersion {
+		if err := newClient(restClient, &cfg).Server.detectVersion(context.Background()); err != nil {
+			return nil, err
+		}
+	}
+
+	return &MultiSiteClient{
+		c:       restClient,
+		cfg:     &cfg,
+		clients: map[string]*Client{},
+	}, nil
+}
+
+// Site return the client of the site specified by its content URL, creating it when needed.
+// The client signs in to the site on its first request. Do not call SwitchSite on the returned client,
+// as the client is reused by later calls using the same content URL.
+func (m *MultiSiteClient) Site(contentUrl string) *Client {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if client, ok := m.clients[contentUrl]; ok {
+		return client
+	}
+
+	cfg := m.cfg.clone()
+	cfg.ContentUrl = contentUrl
+
+	client := newClient(m.c, &cfg)
+	m.clients[contentUrl] = client
+
+	return client
+}
+
+// ContentUrls Returns content URLs of all sites accessible by the user.
+func (m *MultiSiteClient) ContentUrls() ([]string, error) {
+	return m.ContentUrlsContext(context.Background())
+}
+
+// ContentUrlsContext Same as ContentUrls, using ctx to cancel the requests.
+func (m *MultiSiteClient) ContentUrlsContext(ctx context.Context) ([]string, error) {
+	sites, err := m.Site(m.cfg.ContentUrl).Sites.QuerySitesContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	contentUrls := make([]string, 0, len(sites))
+	for _, site := range sites {
+		if site.ContentUrl != nil {
+			contentUrls = append(contentUrls, *site.ContentUrl)
+		}
+	}
+
+	return contentUrls, nil
+}
+
+// SignOut Signs out every site client that is signed in.
+// Errors of each site are returned as SiteErrors.
+func (m *MultiSiteClient) SignOut() error {
+	return m.SignOutContext(context.Background())
+}
+
+// SignOutContext Same as SignOut, using ctx to cancel the requests.
+func (m *MultiSiteClient) SignOutContext(ctx context.Context) error {
+	m.mu.Lock()
+	clients := make(map[string]*Client, len(m.clients))
+	for contentUrl, client := range m.clients {
+		clients[contentUrl] = client
+	}
+	m.mu.Unlock()
+
+	errs := SiteErrors{}
+	for contentUrl, client := range clients {
+		if err := client.Authentication.SignOutContext(ctx); err != nil {
+			errs[contentUrl] = err
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// FanOut runs fn on every site accessible by the user, using the client of each site,
+// and return results in the order of the sites. At most MaxConcurrency sites are run at the same time.
+// When fn fails on some sites, results of all sites are returned along with SiteErrors holding the error of each failed site.
+//
+//	results, err := tableau.FanOut(ctx, m, func(ctx context.Context, client *tableau.Client) ([]models.User, error) {
+//		return client.UsersGroups.GetUsersOnSiteContext(ctx)
+//	})
+func FanOut[T any](ctx context.Context, m *MultiSiteClient, fn func(ctx context.Context, client *Client) (T, error)) ([]SiteResult[T], error) {
+	contentUrls, err := m.ContentUrlsContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return FanOutSites(ctx, m, contentUrls, fn)
+}
+
+// FanOutSites Same as FanOut, running fn only on the sites specified by their content URLs.
+func FanOutSites[T any](ctx context.Context, m *MultiSiteClient, contentUrls []string, fn func(ctx context.Context, client *Client) (T, error)) ([]SiteResult[T], error) {
+	n := m.MaxConcurrency
+	if n <= 0 {
+		n = defaultFanOutConcurrency
+	}
+
+	results := make([]SiteResult[T], len(contentUrls))
+	sem := make(chan struct{}, n)
+	wg := sync.WaitGroup{}
+
+	for i, contentUrl := range contentUrls {
+		results[i].ContentUrl = contentUrl
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			results[i].Err = ctx.Err()
+			continue
+		}
+
+		wg.Add(1)
+		go func(r *SiteResult[T]) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			r.Result, r.Err = fn(ctx, m.Site(r.ContentUrl))
+		}(&results[i])
+	}
+
+	wg.Wait()
+
+	errs := SiteErrors{}
+	for _, r := range results {
+		if r.Err != nil {
+			errs[r.ContentUrl] = r.Err
+		}
+	}
+
+	if len(errs) > 0 {
+		return results, errs
+	}
+
+	return results, nil
+}
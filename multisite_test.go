@@ -0,0 +1,73 @@
+package tableau
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestMultiSiteClientSignInConcurrently(t *testing.T) {
+	contentUrlRe := regexp.MustCompile(`"contentUrl":"([^"]*)"`)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(contentTypeHeader, mimeTypeJSON)
+		if strings.HasSuffix(r.URL.Path, "/auth/signin") {
+			body, _ := io.ReadAll(r.Body)
+			contentUrl := contentUrlRe.FindStringSubmatch(string(body))[1]
+			http.SetCookie(w, &http.Cookie{Name: "workgroup_session_id", Value: contentUrl, Path: "/"})
+			_, _ = w.Write([]byte(`{"credentials":{"token":"` + contentUrl + `","site":{"id":"` + contentUrl + `"},"user":{"id":"user"}}}`))
+			return
+		}
+
+		siteID := strings.Split(r.URL.Path, "/")[4]
+		if token := r.Header.Get(authorizationHeader); token != "Bearer "+siteID {
+			t.Errorf("site %s: unexpected token %q", siteID, token)
+		}
+
+		if cookie, err := r.Cookie("workgroup_session_id"); err == nil && cookie.Value != siteID {
+			t.Errorf("site %s: unexpected session cookie %q", siteID, cookie.Value)
+		}
+
+		_, _ = w.Write([]byte(`{"pagination":{"pageNumber":"1","pageSize":"500","totalAvailable":"1"},"users":{"user":[{"id":"` + siteID + `"}]}}`))
+	}))
+	defer srv.Close()
+
+	m, err := NewMultiSiteClient(Config{Host: srv.URL, Username: "username", Password: "password"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	contentUrls := []string{"site-a", "site-b"}
+	for i := 0; i < 10; i++ {
+		wg := sync.WaitGroup{}
+		for _, contentUrl := range contentUrls {
+			wg.Add(1)
+			go func(contentUrl string) {
+				defer wg.Done()
+				if err := m.Site(contentUrl).Authentication.SignIn(true); err != nil {
+					t.Error(err)
+				}
+			}(contentUrl)
+		}
+
+		wg.Wait()
+
+		results, err := FanOutSites(context.Background(), m, contentUrls, func(ctx context.Context, client *Client) (int, error) {
+			users, err := client.UsersGroups.GetUsersOnSiteContext(ctx)
+			return len(users), err
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, r := range results {
+			if r.Result != 1 {
+				t.Errorf("site %s: expected 1 user, got %d", r.ContentUrl, r.Result)
+			}
+		}
+	}
+}
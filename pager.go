@@ -0,0 +1,133 @@
+package tableau
+
+import (
+	"context"
+	"github.com/tiketdatarisal/tableau/models"
+)
+
+// PageOptions configures page size and starting page number of a Pager.
+// Zero values use the default page size and start from the first page.
+type PageOptions struct {
+	PageSize   int
+	PageNumber int
+}
+
+type pageFetcher[T any] func(ctx context.Context, pageSize, pageNum int) ([]T, *models.Pagination, error)
+
+// Pager requests paginated results page by page, so the caller can process each page and stop early.
+//
+//	pager := client.UsersGroups.GetUsersOnSitePager(tableau.PageOptions{PageSize: 1000})
+//	for pager.Next(ctx) {
+//		for _, user := range pager.Items() {
+//			...
+//		}
+//	}
+//
+//	if err := pager.Err(); err != nil {
+//		...
+//	}
+type Pager[T any] struct {
+	base       *Client
+	fetch      pageFetcher[T]
+	pageSize   int
+	pageNum    int
+	items      []T
+	pagination models.Pagination
+	err        error
+	done       bool
+}
+
+func newPager[T any](base *Client, opt PageOptions, fetch pageFetcher[T]) *Pager[T] {
+	p := &Pager[T]{
+		base:     base,
+		fetch:    fetch,
+		pageSize: opt.PageSize,
+		pageNum:  opt.PageNumber,
+	}
+
+	if p.pageSize <= 0 {
+		p.pageSize = pageSize
+	}
+
+	if p.pageNum <= 0 {
+		p.pageNum = 1
+	}
+
+	return p
+}
+
+// Next requests the next page, and return false when there are no more pages or an error occurred.
+func (p *Pager[T]) Next(ctx context.Context) bool {
+	if p.done || p.err != nil {
+		return false
+	}
+
+	if err := ctx.Err(); err != nil {
+		p.err = err
+		return false
+	}
+
+	if !p.base.Authentication.IsSignedIn() {
+		if err := p.base.Authentication.SignInContext(ctx); err != nil {
+			p.err = err
+			return false
+		}
+	}
+
+	items, pagination, err := p.fetch(ctx, p.pageSize, p.pageNum)
+	if err != nil {
+		p.err = err
+		p.items = nil
+		return false
+	}
+
+	p.items = items
+	if pagination == nil {
+		p.pagination = models.Pagination{}
+		p.done = true
+	} else {
+		// NOTE: The server might clamp the page size, so the pagination it returns is used instead of the requested one
+		p.pagination = *pagination
+		if n := pagination.GetPageNumber(); n > 0 {
+			p.pageNum = n
+		}
+
+		if size := pagination.GetPageSize(); size > 0 {
+			p.pageSize = size
+		}
+
+		p.done = len(items) == 0 || p.pageNum*p.pageSize >= pagination.GetTotalAvailable()
+	}
+
+	p.pageNum++
+	return true
+}
+
+// Items return items of the current page.
+func (p *Pager[T]) Items() []T {
+	return p.items
+}
+
+// Pagination return pagination of the current page, including total available items.
+func (p *Pager[T]) Pagination() models.Pagination {
+	return p.pagination
+}
+
+// Err return the error that stopped the pager, if any.
+func (p *Pager[T]) Err() error {
+	return p.err
+}
+
+// All requests all remaining pages and return their items.
+func (p *Pager[T]) All(ctx context.Context) ([]T, error) {
+	var result []T
+	for p.Next(ctx) {
+		result = append(result, p.Items()...)
+	}
+
+	if err := p.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
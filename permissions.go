@@ -0,0 +1,120 @@
+package tableau
+
+import (
+	"context"
+	"fmt"
+	"github.com/tiketdatarisal/tableau/models"
+	"net/http"
+)
+
+// queryPermissions returns explicit permissions of the resource identified by uri and args.
+func (c *Client) queryPermissions(ctx context.Context, uri string, args ...any) (*models.Permissions, error) {
+	if !c.Authentication.IsSignedIn() {
+		if err := c.Authentication.SignInContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	url := c.cfg.GetUrl(fmt.Sprintf(uri, append([]any{c.Authentication.getSiteID()}, args...)...))
+	if url == "" {
+		return nil, ErrInvalidHost
+	}
+
+	req := c.newRequest(ctx, mimeTypeJSON)
+	res, err := c.execute(req, http.MethodGet, url, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody := models.PermissionsBody{}
+	if err = json.Unmarshal(res.Body(), &resBody); err != nil {
+		return nil, ErrFailedUnmarshalResponseBody
+	}
+
+	return resBody.Permissions, nil
+}
+
+// addPermissions adds grantee capabilities to the resource identified by uri and args.
+func (c *Client) addPermissions(ctx context.Context, grantees []models.GranteeCapabilities, uri string, args ...any) (*models.Permissions, error) {
+	if !c.Authentication.IsSignedIn() {
+		if err := c.Authentication.SignInContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(grantees) == 0 {
+		return nil, ErrBadRequest
+	}
+
+	reqBody := models.PermissionsBody{
+		Permissions: &models.Permissions{
+			GranteeCapabilities: grantees,
+		},
+	}
+
+	url := c.cfg.GetUrl(fmt.Sprintf(uri, append([]any{c.Authentication.getSiteID()}, args...)...))
+	if url == "" {
+		return nil, ErrInvalidHost
+	}
+
+	req := c.newRequest(ctx, mimeTypeJSON).SetBody(reqBody)
+	res, err := c.execute(req, http.MethodPut, url, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody := models.PermissionsBody{}
+	if err = json.Unmarshal(res.Body(), &resBody); err != nil {
+		return nil, ErrFailedUnmarshalResponseBody
+	}
+
+	return resBody.Permissions, nil
+}
+
+// deletePermissions deletes every capability of grantee from the resource identified by uri and args.
+func (c *Client) deletePermissions(ctx context.Context, grantee models.GranteeCapabilities, uri string, args ...any) error {
+	if !c.Authentication.IsSignedIn() {
+		if err := c.Authentication.SignInContext(ctx); err != nil {
+			return err
+		}
+	}
+
+	var granteeType, granteeID string
+	switch {
+	case grantee.User != nil && grantee.User.ID != nil && grantee.Group == nil:
+		granteeType, granteeID = "users", *grantee.User.ID
+	case grantee.Group != nil && grantee.Group.ID != nil && grantee.User == nil:
+		granteeType, granteeID = "groups", *grantee.Group.ID
+	default:
+		return ErrBadRequest
+	}
+
+	if grantee.Capabilities == nil || len(grantee.Capabilities.Capability) == 0 {
+		return ErrBadRequest
+	}
+
+	for _, capability := range grantee.Capabilities.Capability {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		capabilityUri := fmt.Sprintf(deletePermissionUri,
+			fmt.Sprintf(uri, append([]any{c.Authentication.getSiteID()}, args...)...),
+			granteeType,
+			granteeID,
+			capability.Name,
+			capability.Mode)
+
+		url := c.cfg.GetUrl(capabilityUri)
+		if url == "" {
+			return ErrInvalidHost
+		}
+
+		req := c.newRequest(ctx, mimeTypeJSON)
+		if _, err := c.execute(req, http.MethodDelete, url, http.StatusNoContent); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
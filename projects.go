@@ -0,0 +1,307 @@
+package tableau
+
+import (
+	"context"
+	"fmt"
+	"github.com/tiketdatarisal/tableau/models"
+	"net/http"
+)
+
+type projects struct {
+	base *Client
+}
+
+// AddDefaultPermissions Adds permissions to the default permissions of the content type on the specified project for a user or group.
+// Content type is one of the models.DefaultPermissions* constants.
+// Permissions returned by QueryDefaultPermissions can be passed as grantees.
+//
+// URI:
+//
+//	PUT /api/api-version/sites/site-id/projects/project-id/default-permissions/content-type
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_permissions.htm#add_default_permissions
+func (p *projects) AddDefaultPermissions(projectID, contentType string, grantees []models.GranteeCapabilities) (*models.Permissions, error) {
+	return p.AddDefaultPermissionsContext(context.Background(), projectID, contentType, grantees)
+}
+
+// AddDefaultPermissionsContext Same as AddDefaultPermissions, using ctx to cancel the request.
+func (p *projects) AddDefaultPermissionsContext(ctx context.Context, projectID, contentType string, grantees []models.GranteeCapabilities) (*models.Permissions, error) {
+	return p.base.addPermissions(ctx, grantees, defaultPermissionsUri, projectID, contentType)
+}
+
+// AddProjectPermissions Adds permissions to the specified project for a user or group.
+// Permissions returned by QueryProjectPermissions can be passed as grantees.
+//
+// URI:
+//
+//	PUT /api/api-version/sites/site-id/projects/project-id/permissions
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_permissions.htm#add_project_permissions
+func (p *projects) AddProjectPermissions(projectID string, grantees []models.GranteeCapabilities) (*models.Permissions, error) {
+	return p.AddProjectPermissionsContext(context.Background(), projectID, grantees)
+}
+
+// AddProjectPermissionsContext Same as AddProjectPermissions, using ctx to cancel the request.
+func (p *projects) AddProjectPermissionsContext(ctx context.Context, projectID string, grantees []models.GranteeCapabilities) (*models.Permissions, error) {
+	return p.base.addPermissions(ctx, grantees, projectPermissionsUri, projectID)
+}
+
+// CreateProject Creates a project on the specified site.
+// To create a nested project, set ParentProjectID to the ID of the parent project.
+//
+// URI:
+//
+//	POST /api/api-version/sites/site-id/projects
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_projects.htm#create_project
+func (p *projects) CreateProject(project *models.Project) (*models.Project, error) {
+	return p.CreateProjectContext(context.Background(), project)
+}
+
+// CreateProjectContext Same as CreateProject, using ctx to cancel the request.
+func (p *projects) CreateProjectContext(ctx context.Context, project *models.Project) (*models.Project, error) {
+	if !p.base.Authentication.IsSignedIn() {
+		if err := p.base.Authentication.SignInContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if project == nil || project.Name == nil {
+		return nil, ErrBadRequest
+	}
+
+	reqBody := models.ProjectBody{
+		Project: &models.Project{
+			Name:               project.Name,
+			Description:        project.Description,
+			ParentProjectID:    project.ParentProjectID,
+			ContentPermissions: project.ContentPermissions,
+		},
+	}
+
+	url := p.base.cfg.GetUrl(fmt.Sprintf(createProjectUri, p.base.Authentication.getSiteID()))
+	if url == "" {
+		return nil, ErrInvalidHost
+	}
+
+	req := p.base.newRequest(ctx, mimeTypeJSON).SetBody(reqBody)
+	res, err := p.base.execute(req, http.MethodPost, url, http.StatusCreated)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody := models.ProjectBody{}
+	if err = json.Unmarshal(res.Body(), &resBody); err != nil {
+		return nil, ErrFailedUnmarshalResponseBody
+	}
+
+	return resBody.Project, nil
+}
+
+// DeleteDefaultPermissions Deletes every capability of the grantee from the default permissions of the content type on the specified project.
+// The grantee must be either a user or a group with its ID.
+//
+// URI:
+//
+//	DELETE /api/api-version/sites/site-id/projects/project-id/default-permissions/content-type/groups/group-id/capability-name/capability-mode
+//	DELETE /api/api-version/sites/site-id/projects/project-id/default-permissions/content-type/users/user-id/capability-name/capability-mode
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_permissions.htm#delete_default_permission
+func (p *projects) DeleteDefaultPermissions(projectID, contentType string, grantee models.GranteeCapabilities) error {
+	return p.DeleteDefaultPermissionsContext(context.Background(), projectID, contentType, grantee)
+}
+
+// DeleteDefaultPermissionsContext Same as DeleteDefaultPermissions, using ctx to cancel the requests.
+func (p *projects) DeleteDefaultPermissionsContext(ctx context.Context, projectID, contentType string, grantee models.GranteeCapabilities) error {
+	return p.base.deletePermissions(ctx, grantee, defaultPermissionsUri, projectID, contentType)
+}
+
+// DeleteProject Deletes the specified project on a specific site.
+// When a project is deleted, all of its assets are also deleted: associated workbooks, data sources, project view options, and rights.
+//
+// URI:
+//
+//	DELETE /api/api-version/sites/site-id/projects/project-id
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_projects.htm#delete_project
+func (p *projects) DeleteProject(projectID string) error {
+	return p.DeleteProjectContext(context.Background(), projectID)
+}
+
+// DeleteProjectContext Same as DeleteProject, using ctx to cancel the request.
+func (p *projects) DeleteProjectContext(ctx context.Context, projectID string) error {
+	if !p.base.Authentication.IsSignedIn() {
+		if err := p.base.Authentication.SignInContext(ctx); err != nil {
+			return err
+		}
+	}
+
+	url := p.base.cfg.GetUrl(fmt.Sprintf(deleteProjectUri, p.base.Authentication.getSiteID(), projectID))
+	if url == "" {
+		return ErrInvalidHost
+	}
+
+	req := p.base.newRequest(ctx, mimeTypeJSON)
+	if _, err := p.base.execute(req, http.MethodDelete, url, http.StatusNoContent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteProjectPermissions Deletes every capability of the grantee from the specified project permissions.
+// The grantee must be either a user or a group with its ID.
+//
+// URI:
+//
+//	DELETE /api/api-version/sites/site-id/projects/project-id/permissions/groups/group-id/capability-name/capability-mode
+//	DELETE /api/api-version/sites/site-id/projects/project-id/permissions/users/user-id/capability-name/capability-mode
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_permissions.htm#delete_project_permission
+func (p *projects) DeleteProjectPermissions(projectID string, grantee models.GranteeCapabilities) error {
+	return p.DeleteProjectPermissionsContext(context.Background(), projectID, grantee)
+}
+
+// DeleteProjectPermissionsContext Same as DeleteProjectPermissions, using ctx to cancel the requests.
+func (p *projects) DeleteProjectPermissionsContext(ctx context.Context, projectID string, grantee models.GranteeCapabilities) error {
+	return p.base.deletePermissions(ctx, grantee, projectPermissionsUri, projectID)
+}
+
+// QueryDefaultPermissions Returns default permissions of the content type on the specified project.
+// Content type is one of the models.DefaultPermissions* constants.
+//
+// URI:
+//
+//	GET /api/api-version/sites/site-id/projects/project-id/default-permissions/content-type
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_permissions.htm#query_default_permissions
+func (p *projects) QueryDefaultPermissions(projectID, contentType string) (*models.Permissions, error) {
+	return p.QueryDefaultPermissionsContext(context.Background(), projectID, contentType)
+}
+
+// QueryDefaultPermissionsContext Same as QueryDefaultPermissions, using ctx to cancel the request.
+func (p *projects) QueryDefaultPermissionsContext(ctx context.Context, projectID, contentType string) (*models.Permissions, error) {
+	return p.base.queryPermissions(ctx, defaultPermissionsUri, projectID, contentType)
+}
+
+// QueryProjectPermissions Returns a list of permissions for the specified project.
+//
+// URI:
+//
+//	GET /api/api-version/sites/site-id/projects/project-id/permissions
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_permissions.htm#query_project_permissions
+func (p *projects) QueryProjectPermissions(projectID string) (*models.Permissions, error) {
+	return p.QueryProjectPermissionsContext(context.Background(), projectID)
+}
+
+// QueryProjectPermissionsContext Same as QueryProjectPermissions, using ctx to cancel the request.
+func (p *projects) QueryProjectPermissionsContext(ctx context.Context, projectID string) (*models.Permissions, error) {
+	return p.base.queryPermissions(ctx, projectPermissionsUri, projectID)
+}
+
+// QueryProjects Returns a list of projects on the specified site, optionally filtered and sorted.
+//
+// URI:
+//
+//	GET /api/api-version/sites/site-id/projects
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_projects.htm#query_projects
+func (p *projects) QueryProjects(f models.Filter, s models.Sort) ([]models.Project, error) {
+	return p.QueryProjectsContext(context.Background(), f, s)
+}
+
+// QueryProjectsContext Same as QueryProjects, using ctx to cancel the requests.
+// No more pages are requested after ctx is done.
+func (p *projects) QueryProjectsContext(ctx context.Context, f models.Filter, s models.Sort) ([]models.Project, error) {
+	return p.QueryProjectsPager(PageOptions{}, f, s).All(ctx)
+}
+
+// QueryProjectsPager Same as QueryProjects, returning a pager that requests the projects page by page.
+func (p *projects) QueryProjectsPager(opt PageOptions, f models.Filter, s models.Sort) *Pager[models.Project] {
+	return newPager(p.base, opt, func(ctx context.Context, size, num int) ([]models.Project, *models.Pagination, error) {
+		url := p.base.cfg.GetUrl(fmt.Sprintf(queryProjectsUri, p.base.Authentication.getSiteID()))
+		if url == "" {
+			return nil, nil, ErrInvalidHost
+		}
+
+		url = fmt.Sprintf(queryProjectsParams, url, size, num)
+		if len(f) > 0 {
+			url = url + "&filter=" + f.String()
+		}
+
+		if len(s) > 0 {
+			url = url + "&sort=" + s.String()
+		}
+
+		req := p.base.newRequest(ctx, mimeTypeJSON)
+		res, err := p.base.execute(req, http.MethodGet, url, http.StatusOK)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		resBody := models.QueryProjectBody{}
+		if err = json.Unmarshal(res.Body(), &resBody); err != nil {
+			return nil, nil, ErrFailedUnmarshalResponseBody
+		}
+
+		if resBody.Projects == nil {
+			return nil, resBody.Pagination, nil
+		}
+
+		return resBody.Projects.Project, resBody.Pagination, nil
+	})
+}
+
+// UpdateProject Updates the name, description, parent project, content permissions or owner of the specified project.
+// Set ParentProjectID to an empty string to move the project to the top level.
+//
+// URI:
+//
+//	PUT /api/api-version/sites/site-id/projects/project-id
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_projects.htm#update_project
+func (p *projects) UpdateProject(project *models.Project) (*models.Project, error) {
+	return p.UpdateProjectContext(context.Background(), project)
+}
+
+// UpdateProjectContext Same as UpdateProject, using ctx to cancel the request.
+func (p *projects) UpdateProjectContext(ctx context.Context, project *models.Project) (*models.Project, error) {
+	if !p.base.Authentication.IsSignedIn() {
+		if err := p.base.Authentication.SignInContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if project == nil || project.ID == nil {
+		return nil, ErrBadRequest
+	}
+
+	reqBody := models.ProjectBody{
+		Project: &models.Project{
+			Name:               project.Name,
+			Description:        project.Description,
+			ParentProjectID:    project.ParentProjectID,
+			ContentPermissions: project.ContentPermissions,
+			Owner:              project.Owner,
+		},
+	}
+
+	url := p.base.cfg.GetUrl(fmt.Sprintf(updateProjectUri, p.base.Authentication.getSiteID(), *project.ID))
+	if url == "" {
+		return nil, ErrInvalidHost
+	}
+
+	req := retryIdempotent(p.base.newRequest(ctx, mimeTypeJSON).SetBody(reqBody))
+	res, err := p.base.execute(req, http.MethodPut, url, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody := models.ProjectBody{}
+	if err = json.Unmarshal(res.Body(), &resBody); err != nil {
+		return nil, ErrFailedUnmarshalResponseBody
+	}
+
+	return resBody.Project, nil
+}
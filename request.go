@@ -0,0 +1,139 @@
+package tableau
+
+import (
+	"context"
+	"github.com/go-resty/resty/v2"
+	"io"
+	"mime"
+	"net/http"
+)
+
+// requireVersion return VersionError when the REST API version used by the client is older than minVersion.
+func (c *Client) requireVersion(feature, minVersion string) error {
+	if compareVersion(c.cfg.Version, minVersion) < 0 {
+		return &VersionError{Feature: feature, Version: c.cfg.Version, MinVersion: minVersion}
+	}
+
+	return nil
+}
+
+// newRequest create a new request bound to ctx, with default headers and current bearer token.
+func (c *Client) newRequest(ctx context.Context, accept string) *resty.Request {
+	req := c.c.R().
+		SetContext(ctx).
+		SetHeader(contentTypeHeader, mimeTypeJSON).
+		SetHeader(acceptHeader, accept)
+
+	if token := c.Authentication.getBearerToken(); token != "" {
+		req.SetHeader(authorizationHeader, token)
+	}
+
+	return req
+}
+
+// execute sends req and return the response when its status code is one of the expected status codes.
+// When the server rejects the bearer token, it signs in again and resends req once using the new token.
+func (c *Client) execute(req *resty.Request, method, url string, status ...int) (*resty.Response, error) {
+	res, err := c.send(req, method, url)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() == http.StatusUnauthorized {
+		closeRawBody(res)
+		if err = c.Authentication.refreshSession(req.Context(), req.Header.Get(authorizationHeader)); err != nil {
+			return nil, err
+		}
+
+		req.SetHeader(authorizationHeader, c.Authentication.getBearerToken())
+		if res, err = c.send(req, method, url); err != nil {
+			return nil, err
+		}
+	}
+
+	return checkStatus(res, status...)
+}
+
+// executeOnce same as execute, without signing in again. Used by authentication methods.
+func (c *Client) executeOnce(req *resty.Request, method, url string, status ...int) (*resty.Response, error) {
+	res, err := c.send(req, method, url)
+	if err != nil {
+		return nil, err
+	}
+
+	return checkStatus(res, status...)
+}
+
+// send sends req, saving the response as the last response, and into the response captured by ctx if any.
+// Idempotent requests, and requests marked by retryIdempotent, are retried as configured by the retry policy.
+// When the request context is done, context error is returned.
+func (c *Client) send(req *resty.Request, method, url string) (*resty.Response, error) {
+	for attempt := 1; ; attempt++ {
+		res, err := req.Execute(method, url)
+		if res != nil {
+			c.SetResponse(*res)
+			if p, ok := req.Context().Value(responseKey{}).(*resty.Response); ok && p != nil {
+				*p = *res
+			}
+		}
+
+		if err != nil {
+			if ctxErr := req.Context().Err(); ctxErr != nil {
+				return nil, ctxErr
+			}
+
+			return nil, &TransportError{Method: method, URL: url, Err: err}
+		}
+
+		if !c.cfg.Retry.shouldRetry(req, method, res, attempt) {
+			return res, nil
+		}
+
+		closeRawBody(res)
+		if err = sleep(req.Context(), c.cfg.Retry.backoff(res, attempt)); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// checkStatus return res when its status code is one of the expected status codes, otherwise return APIError.
+func checkStatus(res *resty.Response, status ...int) (*resty.Response, error) {
+	for _, s := range status {
+		if res.StatusCode() == s {
+			return res, nil
+		}
+	}
+
+	return nil, newAPIError(res)
+}
+
+// download sends req as GET request and streams the response body into w, without loading it into memory.
+// It returns the file name from Content-Disposition header, if any.
+func (c *Client) download(req *resty.Request, url string, w io.Writer) (string, error) {
+	req.SetDoNotParseResponse(true)
+	res, err := c.execute(req, http.MethodGet, url, http.StatusOK)
+	if err != nil {
+		return "", err
+	}
+
+	body := res.RawBody()
+	defer body.Close()
+
+	if _, err = io.Copy(w, body); err != nil {
+		if ctxErr := req.Context().Err(); ctxErr != nil {
+			return "", ctxErr
+		}
+
+		return "", &TransportError{Method: http.MethodGet, URL: url, Err: err}
+	}
+
+	_, params, _ := mime.ParseMediaType(res.Header().Get(contentDispositionHeader))
+	return params["filename"], nil
+}
+
+// closeRawBody closes the response body of requests that do not parse the response.
+func closeRawBody(res *resty.Response) {
+	if body := res.RawBody(); body != nil {
+		_ = body.Close()
+	}
+}
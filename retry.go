@@ -0,0 +1,122 @@
+package tableau
+
+import (
+	"context"
+	"github.com/go-resty/resty/v2"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures how requests that failed with 429 or 5xx status code are retried.
+// Only idempotent requests (GET, HEAD and DELETE) are retried, including each page of paginated requests.
+// PUT requests are only retried by update methods, as other PUT requests may not be idempotent.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including the first one. Default is 3.
+	MaxAttempts int
+	// MinBackoff is the base wait time before the first retry, doubled on each following retry.
+	MinBackoff time.Duration
+	// MaxBackoff is the maximum wait time before a retry, including the wait time asked by the server using Retry-After header.
+	MaxBackoff time.Duration
+}
+
+func (p *RetryPolicy) init() {
+	if p.MaxAttempts < 1 {
+		p.MaxAttempts = defaultMaxAttempts
+	}
+
+	if p.MinBackoff <= 0 {
+		p.MinBackoff = defaultMinBackoff
+	}
+
+	if p.MaxBackoff < p.MinBackoff {
+		p.MaxBackoff = defaultMaxBackoff
+		if p.MaxBackoff < p.MinBackoff {
+			p.MaxBackoff = p.MinBackoff
+		}
+	}
+}
+
+type retryKey struct{}
+
+// retryIdempotent return req marked as idempotent, so the retry policy retries it even when its method is PUT.
+// Only use it for update requests that give the same result when applied twice.
+func retryIdempotent(req *resty.Request) *resty.Request {
+	return req.SetContext(context.WithValue(req.Context(), retryKey{}, true))
+}
+
+// shouldRetry return true when res of attempt number attempt of req should be retried.
+func (p *RetryPolicy) shouldRetry(req *resty.Request, method string, res *resty.Response, attempt int) bool {
+	if p == nil || attempt >= p.MaxAttempts {
+		return false
+	}
+
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodDelete:
+	case http.MethodPut:
+		if retry, _ := req.Context().Value(retryKey{}).(bool); !retry {
+			return false
+		}
+	default:
+		return false
+	}
+
+	code := res.StatusCode()
+	return code == http.StatusTooManyRequests || (code >= http.StatusInternalServerError && code != http.StatusNotImplemented)
+}
+
+// backoff return wait time before retrying attempt number attempt.
+// Retry-After header is used when available, capped at MaxBackoff, otherwise exponential backoff with full jitter is used.
+func (p *RetryPolicy) backoff(res *resty.Response, attempt int) time.Duration {
+	if d, ok := retryAfter(res.Header().Get(retryAfterHeader)); ok {
+		if d > p.MaxBackoff {
+			return p.MaxBackoff
+		}
+
+		return d
+	}
+
+	d := p.MaxBackoff
+	if shift := attempt - 1; shift < 32 {
+		if exp := p.MinBackoff << shift; exp > 0 && exp < p.MaxBackoff {
+			d = exp
+		}
+	}
+
+	return time.Duration(rand.Int63n(int64(d) + 1))
+}
+
+// retryAfter parse Retry-After header value, either in seconds or HTTP date.
+func retryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if s, err := strconv.Atoi(value); err == nil && s >= 0 {
+		return time.Duration(s) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(value); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d, true
+		}
+
+		return 0, true
+	}
+
+	return 0, false
+}
+
+// sleep waits for d, or returns context error when ctx is done first.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,429 @@
+package tableau
+
+import (
+	"context"
+	"fmt"
+	"github.com/tiketdatarisal/tableau/models"
+	"net/http"
+)
+
+type schedules struct {
+	base *Client
+}
+
+// AddDataSourceToSchedule Adds a task to refresh a data source to an existing schedule, and returns the extract refresh task.
+//
+// URI:
+//
+//	PUT /api/api-version/sites/site-id/schedules/schedule-id/datasources
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_jobs_tasks_and_schedules.htm#add_data_source_to_schedule
+func (s *schedules) AddDataSourceToSchedule(scheduleID, dataSourceID string) (*models.ExtractRefresh, error) {
+	return s.AddDataSourceToScheduleContext(context.Background(), scheduleID, dataSourceID)
+}
+
+// AddDataSourceToScheduleContext Same as AddDataSourceToSchedule, using ctx to cancel the request.
+func (s *schedules) AddDataSourceToScheduleContext(ctx context.Context, scheduleID, dataSourceID string) (*models.ExtractRefresh, error) {
+	task := &models.ExtractRefresh{DataSource: &models.DataSource{ID: &dataSourceID}}
+	return s.addToSchedule(ctx, task, addDataSourceToScheduleUri, scheduleID)
+}
+
+// AddWorkbookToSchedule Adds a task to refresh or accelerate a workbook to an existing schedule, and returns the extract refresh task.
+//
+// URI:
+//
+//	PUT /api/api-version/sites/site-id/schedules/schedule-id/workbooks
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_jobs_tasks_and_schedules.htm#add_workbook_to_schedule
+func (s *schedules) AddWorkbookToSchedule(scheduleID, workbookID string) (*models.ExtractRefresh, error) {
+	return s.AddWorkbookToScheduleContext(context.Background(), scheduleID, workbookID)
+}
+
+// AddWorkbookToScheduleContext Same as AddWorkbookToSchedule, using ctx to cancel the request.
+func (s *schedules) AddWorkbookToScheduleContext(ctx context.Context, scheduleID, workbookID string) (*models.ExtractRefresh, error) {
+	task := &models.ExtractRefresh{Workbook: &models.Workbook{ID: &workbookID}}
+	return s.addToSchedule(ctx, task, addWorkbookToScheduleUri, scheduleID)
+}
+
+// CreateSchedule Creates a new server schedule.
+// Name, Type, Frequency and FrequencyDetails of the schedule are required.
+//
+// URI:
+//
+//	POST /api/api-version/schedules
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_jobs_tasks_and_schedules.htm#create_schedule
+func (s *schedules) CreateSchedule(schedule *models.Schedule) (*models.Schedule, error) {
+	return s.CreateScheduleContext(context.Background(), schedule)
+}
+
+// CreateScheduleContext Same as CreateSchedule, using ctx to cancel the request.
+func (s *schedules) CreateScheduleContext(ctx context.Context, schedule *models.Schedule) (*models.Schedule, error) {
+	if !s.base.Authentication.IsSignedIn() {
+		if err := s.base.Authentication.SignInContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if schedule == nil || schedule.Name == nil || schedule.Type == nil || schedule.Frequency == nil || schedule.FrequencyDetails == nil {
+		return nil, ErrBadRequest
+	}
+
+	reqBody := models.ScheduleBody{
+		Schedule: &models.Schedule{
+			Name:             schedule.Name,
+			Priority:         schedule.Priority,
+			Type:             schedule.Type,
+			Frequency:        schedule.Frequency,
+			ExecutionOrder:   schedule.ExecutionOrder,
+			FrequencyDetails: schedule.FrequencyDetails,
+		},
+	}
+
+	url := s.base.cfg.GetUrl(createScheduleUri)
+	if url == "" {
+		return nil, ErrInvalidHost
+	}
+
+	req := s.base.newRequest(ctx, mimeTypeJSON).SetBody(reqBody)
+	res, err := s.base.execute(req, http.MethodPost, url, http.StatusCreated)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody := models.ScheduleBody{}
+	if err = json.Unmarshal(res.Body(), &resBody); err != nil {
+		return nil, ErrFailedUnmarshalResponseBody
+	}
+
+	return resBody.Schedule, nil
+}
+
+// DeleteExtractRefreshTask Deletes the specified extract refresh task.
+//
+// URI:
+//
+//	DELETE /api/api-version/sites/site-id/tasks/extractRefreshes/task-id
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_jobs_tasks_and_schedules.htm#delete_extract_refresh_task
+func (s *schedules) DeleteExtractRefreshTask(taskID string) error {
+	return s.DeleteExtractRefreshTaskContext(context.Background(), taskID)
+}
+
+// DeleteExtractRefreshTaskContext Same as DeleteExtractRefreshTask, using ctx to cancel the request.
+func (s *schedules) DeleteExtractRefreshTaskContext(ctx context.Context, taskID string) error {
+	if !s.base.Authentication.IsSignedIn() {
+		if err := s.base.Authentication.SignInContext(ctx); err != nil {
+			return err
+		}
+	}
+
+	url := s.base.cfg.GetUrl(fmt.Sprintf(deleteExtractRefreshTaskUri, s.base.Authentication.getSiteID(), taskID))
+	if url == "" {
+		return ErrInvalidHost
+	}
+
+	req := s.base.newRequest(ctx, mimeTypeJSON)
+	if _, err := s.base.execute(req, http.MethodDelete, url, http.StatusNoContent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteSchedule Deletes the specified server schedule.
+//
+// URI:
+//
+//	DELETE /api/api-version/schedules/schedule-id
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_jobs_tasks_and_schedules.htm#delete_schedule
+func (s *schedules) DeleteSchedule(scheduleID string) error {
+	return s.DeleteScheduleContext(context.Background(), scheduleID)
+}
+
+// DeleteScheduleContext Same as DeleteSchedule, using ctx to cancel the request.
+func (s *schedules) DeleteScheduleContext(ctx context.Context, scheduleID string) error {
+	if !s.base.Authentication.IsSignedIn() {
+		if err := s.base.Authentication.SignInContext(ctx); err != nil {
+			return err
+		}
+	}
+
+	url := s.base.cfg.GetUrl(fmt.Sprintf(deleteScheduleUri, scheduleID))
+	if url == "" {
+		return ErrInvalidHost
+	}
+
+	req := s.base.newRequest(ctx, mimeTypeJSON)
+	if _, err := s.base.execute(req, http.MethodDelete, url, http.StatusNoContent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetExtractRefreshTask Returns information about the specified extract refresh task.
+//
+// URI:
+//
+//	GET /api/api-version/sites/site-id/tasks/extractRefreshes/task-id
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_jobs_tasks_and_schedules.htm#get_extract_refresh_task
+func (s *schedules) GetExtractRefreshTask(taskID string) (*models.ExtractRefresh, error) {
+	return s.GetExtractRefreshTaskContext(context.Background(), taskID)
+}
+
+// GetExtractRefreshTaskContext Same as GetExtractRefreshTask, using ctx to cancel the request.
+func (s *schedules) GetExtractRefreshTaskContext(ctx context.Context, taskID string) (*models.ExtractRefresh, error) {
+	if !s.base.Authentication.IsSignedIn() {
+		if err := s.base.Authentication.SignInContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	url := s.base.cfg.GetUrl(fmt.Sprintf(getExtractRefreshTaskUri, s.base.Authentication.getSiteID(), taskID))
+	if url == "" {
+		return nil, ErrInvalidHost
+	}
+
+	req := s.base.newRequest(ctx, mimeTypeJSON)
+	res, err := s.base.execute(req, http.MethodGet, url, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody := models.TaskBody{}
+	if err = json.Unmarshal(res.Body(), &resBody); err != nil {
+		return nil, ErrFailedUnmarshalResponseBody
+	}
+
+	if resBody.Task == nil {
+		return nil, nil
+	}
+
+	return resBody.Task.ExtractRefresh, nil
+}
+
+// ListExtractRefreshTasks Returns a list of extract refresh tasks for the site, including their schedules.
+//
+// URI:
+//
+//	GET /api/api-version/sites/site-id/tasks/extractRefreshes
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_jobs_tasks_and_schedules.htm#list_extract_refresh_tasks1
+func (s *schedules) ListExtractRefreshTasks() ([]models.ExtractRefresh, error) {
+	return s.ListExtractRefreshTasksContext(context.Background())
+}
+
+// ListExtractRefreshTasksContext Same as ListExtractRefreshTasks, using ctx to cancel the request.
+func (s *schedules) ListExtractRefreshTasksContext(ctx context.Context) ([]models.ExtractRefresh, error) {
+	if !s.base.Authentication.IsSignedIn() {
+		if err := s.base.Authentication.SignInContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	url := s.base.cfg.GetUrl(fmt.Sprintf(listExtractRefreshTasksUri, s.base.Authentication.getSiteID()))
+	if url == "" {
+		return nil, ErrInvalidHost
+	}
+
+	req := s.base.newRequest(ctx, mimeTypeJSON)
+	res, err := s.base.execute(req, http.MethodGet, url, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody := models.QueryTaskBody{}
+	if err = json.Unmarshal(res.Body(), &resBody); err != nil {
+		return nil, ErrFailedUnmarshalResponseBody
+	}
+
+	if resBody.Tasks == nil {
+		return nil, nil
+	}
+
+	var tasks []models.ExtractRefresh
+	for _, task := range resBody.Tasks.Task {
+		if task.ExtractRefresh != nil {
+			tasks = append(tasks, *task.ExtractRefresh)
+		}
+	}
+
+	return tasks, nil
+}
+
+// QuerySchedules Returns a list of flows, extract and subscription schedules on the server.
+//
+// URI:
+//
+//	GET /api/api-version/schedules
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_jobs_tasks_and_schedules.htm#query_schedules
+func (s *schedules) QuerySchedules() ([]models.Schedule, error) {
+	return s.QuerySchedulesContext(context.Background())
+}
+
+// QuerySchedulesContext Same as QuerySchedules, using ctx to cancel the requests.
+// No more pages are requested after ctx is done.
+func (s *schedules) QuerySchedulesContext(ctx context.Context) ([]models.Schedule, error) {
+	return s.QuerySchedulesPager(PageOptions{}).All(ctx)
+}
+
+// QuerySchedulesPager Same as QuerySchedules, returning a pager that requests the schedules page by page.
+func (s *schedules) QuerySchedulesPager(opt PageOptions) *Pager[models.Schedule] {
+	return newPager(s.base, opt, func(ctx context.Context, size, num int) ([]models.Schedule, *models.Pagination, error) {
+		url := s.base.cfg.GetUrl(querySchedulesUri)
+		if url == "" {
+			return nil, nil, ErrInvalidHost
+		}
+
+		url = fmt.Sprintf(querySchedulesParams, url, size, num)
+
+		req := s.base.newRequest(ctx, mimeTypeJSON)
+		res, err := s.base.execute(req, http.MethodGet, url, http.StatusOK)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		resBody := models.QueryScheduleBody{}
+		if err = json.Unmarshal(res.Body(), &resBody); err != nil {
+			return nil, nil, ErrFailedUnmarshalResponseBody
+		}
+
+		if resBody.Schedules == nil {
+			return nil, resBody.Pagination, nil
+		}
+
+		return resBody.Schedules.Schedule, resBody.Pagination, nil
+	})
+}
+
+// RunExtractRefreshTask Runs the specified extract refresh task, and returns the extract refresh job.
+// Use Jobs.WaitForJob to wait until the refresh is completed.
+//
+// URI:
+//
+//	POST /api/api-version/sites/site-id/tasks/extractRefreshes/task-id/runNow
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_jobs_tasks_and_schedules.htm#run_extract_refresh_task
+func (s *schedules) RunExtractRefreshTask(taskID string) (*models.Job, error) {
+	return s.RunExtractRefreshTaskContext(context.Background(), taskID)
+}
+
+// RunExtractRefreshTaskContext Same as RunExtractRefreshTask, using ctx to cancel the request.
+func (s *schedules) RunExtractRefreshTaskContext(ctx context.Context, taskID string) (*models.Job, error) {
+	if !s.base.Authentication.IsSignedIn() {
+		if err := s.base.Authentication.SignInContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	url := s.base.cfg.GetUrl(fmt.Sprintf(runExtractRefreshTaskUri, s.base.Authentication.getSiteID(), taskID))
+	if url == "" {
+		return nil, ErrInvalidHost
+	}
+
+	req := s.base.newRequest(ctx, mimeTypeJSON).SetBody(struct{}{})
+	res, err := s.base.execute(req, http.MethodPost, url, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody := models.JobBody{}
+	if err = json.Unmarshal(res.Body(), &resBody); err != nil {
+		return nil, ErrFailedUnmarshalResponseBody
+	}
+
+	return resBody.Job, nil
+}
+
+// UpdateSchedule Modifies settings for the specified server schedule, including its name, state, priority, execution order and frequency.
+//
+// URI:
+//
+//	PUT /api/api-version/schedules/schedule-id
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_jobs_tasks_and_schedules.htm#update_schedule
+func (s *schedules) UpdateSchedule(schedule *models.Schedule) (*models.Schedule, error) {
+	return s.UpdateScheduleContext(context.Background(), schedule)
+}
+
+// UpdateScheduleContext Same as UpdateSchedule, using ctx to cancel the request.
+func (s *schedules) UpdateScheduleContext(ctx context.Context, schedule *models.Schedule) (*models.Schedule, error) {
+	if !s.base.Authentication.IsSignedIn() {
+		if err := s.base.Authentication.SignInContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if schedule == nil || schedule.ID == nil {
+		return nil, ErrBadRequest
+	}
+
+	reqBody := models.ScheduleBody{
+		Schedule: &models.Schedule{
+			Name:             schedule.Name,
+			State:            schedule.State,
+			Priority:         schedule.Priority,
+			Frequency:        schedule.Frequency,
+			ExecutionOrder:   schedule.ExecutionOrder,
+			FrequencyDetails: schedule.FrequencyDetails,
+		},
+	}
+
+	url := s.base.cfg.GetUrl(fmt.Sprintf(updateScheduleUri, *schedule.ID))
+	if url == "" {
+		return nil, ErrInvalidHost
+	}
+
+	req := retryIdempotent(s.base.newRequest(ctx, mimeTypeJSON).SetBody(reqBody))
+	res, err := s.base.execute(req, http.MethodPut, url, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody := models.ScheduleBody{}
+	if err = json.Unmarshal(res.Body(), &resBody); err != nil {
+		return nil, ErrFailedUnmarshalResponseBody
+	}
+
+	return resBody.Schedule, nil
+}
+
+// addToSchedule adds the extract refresh task to the schedule identified by uri and args.
+// The request is never retried, as a resent request would create a duplicate task.
+func (s *schedules) addToSchedule(ctx context.Context, task *models.ExtractRefresh, uri string, args ...any) (*models.ExtractRefresh, error) {
+	if !s.base.Authentication.IsSignedIn() {
+		if err := s.base.Authentication.SignInContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	reqBody := models.TaskBody{
+		Task: &models.Task{ExtractRefresh: task},
+	}
+
+	url := s.base.cfg.GetUrl(fmt.Sprintf(uri, append([]any{s.base.Authentication.getSiteID()}, args...)...))
+	if url == "" {
+		return nil, ErrInvalidHost
+	}
+
+	req := s.base.newRequest(ctx, mimeTypeJSON).SetBody(reqBody)
+	res, err := s.base.execute(req, http.MethodPut, url, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody := models.TaskBody{}
+	if err = json.Unmarshal(res.Body(), &resBody); err != nil {
+		return nil, ErrFailedUnmarshalResponseBody
+	}
+
+	if resBody.Task == nil {
+		return nil, nil
+	}
+
+	return resBody.Task.ExtractRefresh, nil
+}
@@ -0,0 +1,69 @@
+package tableau
+
+import (
+	"context"
+	"github.com/tiketdatarisal/tableau/models"
+	"net/http"
+)
+
+type server struct {
+	base *Client
+}
+
+// GetServerInfo Returns the version of Tableau Server, its build number, and the highest REST API version it supports.
+// This method does not require signing in.
+//
+// URI:
+//
+//	GET /api/api-version/serverinfo
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_server.htm#server_info
+func (s *server) GetServerInfo() (*models.ServerInfo, error) {
+	return s.GetServerInfoContext(context.Background())
+}
+
+// GetServerInfoContext Same as GetServerInfo, using ctx to cancel the request.
+func (s *server) GetServerInfoContext(ctx context.Context) (*models.ServerInfo, error) {
+	if err := s.base.requireVersion("GetServerInfo", minVersionServerInfo); err != nil {
+		return nil, err
+	}
+
+	return s.getServerInfo(ctx, s.base.cfg.Version)
+}
+
+// detectVersion sets the REST API version used by the client to the highest version supported by the server.
+func (s *server) detectVersion(ctx context.Context) error {
+	info, err := s.getServerInfo(ctx, minVersionServerInfo)
+	if err != nil {
+		return err
+	}
+
+	if info == nil || info.RestApiVersion == nil || *info.RestApiVersion == "" {
+		return ErrFailedUnmarshalResponseBody
+	}
+
+	s.base.cfg.Version = *info.RestApiVersion
+
+	return nil
+}
+
+// getServerInfo returns server info requested using the specified REST API version.
+func (s *server) getServerInfo(ctx context.Context, version string) (*models.ServerInfo, error) {
+	url := s.base.cfg.getVersionUrl(version, serverInfoUri)
+	if url == "" {
+		return nil, ErrInvalidHost
+	}
+
+	req := s.base.newRequest(ctx, mimeTypeJSON)
+	res, err := s.base.executeOnce(req, http.MethodGet, url, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody := models.ServerInfoBody{}
+	if err = json.Unmarshal(res.Body(), &resBody); err != nil {
+		return nil, ErrFailedUnmarshalResponseBody
+	}
+
+	return resBody.ServerInfo, nil
+}
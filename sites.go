@@ -0,0 +1,307 @@
+package tableau
+
+import (
+	"context"
+	"fmt"
+	"github.com/tiketdatarisal/tableau/models"
+	"net/http"
+)
+
+type sites struct {
+	base *Client
+}
+
+// CreateSite Creates a site on Tableau Server. Name and ContentUrl of the site are required.
+// To make changes to the new site, call Authentication.SwitchSite to switch to it first.
+//
+// URI:
+//
+//	POST /api/api-version/sites
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_site.htm#create_site
+func (s *sites) CreateSite(site *models.Site) (*models.Site, error) {
+	return s.CreateSiteContext(context.Background(), site)
+}
+
+// CreateSiteContext Same as CreateSite, using ctx to cancel the request.
+func (s *sites) CreateSiteContext(ctx context.Context, site *models.Site) (*models.Site, error) {
+	if !s.base.Authentication.IsSignedIn() {
+		if err := s.base.Authentication.SignInContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if site == nil || site.Name == nil || site.ContentUrl == nil {
+		return nil, ErrBadRequest
+	}
+
+	reqBody := models.SiteBody{Site: newSiteRequest(site)}
+
+	url := s.base.cfg.GetUrl(createSiteUri)
+	if url == "" {
+		return nil, ErrInvalidHost
+	}
+
+	req := s.base.newRequest(ctx, mimeTypeJSON).SetBody(reqBody)
+	res, err := s.base.execute(req, http.MethodPost, url, http.StatusCreated)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody := models.SiteBody{}
+	if err = json.Unmarshal(res.Body(), &resBody); err != nil {
+		return nil, ErrFailedUnmarshalResponseBody
+	}
+
+	return resBody.Site, nil
+}
+
+// DeleteSite Deletes the specified site, including all of its content and users.
+// The session becomes invalid when the signed-in site is deleted.
+//
+// URI:
+//
+//	DELETE /api/api-version/sites/site-id
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_site.htm#delete_site
+func (s *sites) DeleteSite(siteID string) error {
+	return s.DeleteSiteContext(context.Background(), siteID)
+}
+
+// DeleteSiteContext Same as DeleteSite, using ctx to cancel the request.
+func (s *sites) DeleteSiteContext(ctx context.Context, siteID string) error {
+	if !s.base.Authentication.IsSignedIn() {
+		if err := s.base.Authentication.SignInContext(ctx); err != nil {
+			return err
+		}
+	}
+
+	url := s.base.cfg.GetUrl(fmt.Sprintf(deleteSiteUri, siteID))
+	if url == "" {
+		return ErrInvalidHost
+	}
+
+	req := s.base.newRequest(ctx, mimeTypeJSON)
+	if _, err := s.base.execute(req, http.MethodDelete, url, http.StatusNoContent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSiteUsage Returns the number of users and storage in megabytes used by the specified site.
+//
+// URI:
+//
+//	GET /api/api-version/sites/site-id?includeUsageStatistics=true
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_site.htm#query_site
+func (s *sites) GetSiteUsage(siteID string) (*models.SiteUsage, error) {
+	return s.GetSiteUsageContext(context.Background(), siteID)
+}
+
+// GetSiteUsageContext Same as GetSiteUsage, using ctx to cancel the request.
+func (s *sites) GetSiteUsageContext(ctx context.Context, siteID string) (*models.SiteUsage, error) {
+	site, err := s.querySite(ctx, siteID, siteUsageParams)
+	if err != nil {
+		return nil, err
+	}
+
+	if site == nil {
+		return nil, nil
+	}
+
+	return site.Usage, nil
+}
+
+// QuerySite Returns information about the specified site.
+//
+// URI:
+//
+//	GET /api/api-version/sites/site-id
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_site.htm#query_site
+func (s *sites) QuerySite(siteID string) (*models.Site, error) {
+	return s.QuerySiteContext(context.Background(), siteID)
+}
+
+// QuerySiteContext Same as QuerySite, using ctx to cancel the request.
+func (s *sites) QuerySiteContext(ctx context.Context, siteID string) (*models.Site, error) {
+	return s.querySite(ctx, siteID, "")
+}
+
+// QuerySiteByContentUrl Same as QuerySite, using the content URL of the site instead of its ID.
+//
+// URI:
+//
+//	GET /api/api-version/sites/site-content-url?key=contentUrl
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_site.htm#query_site
+func (s *sites) QuerySiteByContentUrl(contentUrl string) (*models.Site, error) {
+	return s.QuerySiteByContentUrlContext(context.Background(), contentUrl)
+}
+
+// QuerySiteByContentUrlContext Same as QuerySiteByContentUrl, using ctx to cancel the request.
+func (s *sites) QuerySiteByContentUrlContext(ctx context.Context, contentUrl string) (*models.Site, error) {
+	return s.querySite(ctx, contentUrl, fmt.Sprintf(querySiteByKeyParams, siteKeyContentUrl))
+}
+
+// QuerySiteByName Same as QuerySite, using the name of the site instead of its ID.
+//
+// URI:
+//
+//	GET /api/api-version/sites/site-name?key=name
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_site.htm#query_site
+func (s *sites) QuerySiteByName(name string) (*models.Site, error) {
+	return s.QuerySiteByNameContext(context.Background(), name)
+}
+
+// QuerySiteByNameContext Same as QuerySiteByName, using ctx to cancel the request.
+func (s *sites) QuerySiteByNameContext(ctx context.Context, name string) (*models.Site, error) {
+	return s.querySite(ctx, name, fmt.Sprintf(querySiteByKeyParams, siteKeyName))
+}
+
+// QuerySites Returns a list of the sites on the server that the caller of this method has access to.
+//
+// URI:
+//
+//	GET /api/api-version/sites
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_site.htm#query_sites
+func (s *sites) QuerySites() ([]models.Site, error) {
+	return s.QuerySitesContext(context.Background())
+}
+
+// QuerySitesContext Same as QuerySites, using ctx to cancel the requests.
+// No more pages are requested after ctx is done.
+func (s *sites) QuerySitesContext(ctx context.Context) ([]models.Site, error) {
+	return s.QuerySitesPager(PageOptions{}).All(ctx)
+}
+
+// QuerySitesPager Same as QuerySites, returning a pager that requests the sites page by page.
+func (s *sites) QuerySitesPager(opt PageOptions) *Pager[models.Site] {
+	return newPager(s.base, opt, func(ctx context.Context, size, num int) ([]models.Site, *models.Pagination, error) {
+		url := s.base.cfg.GetUrl(querySitesUri)
+		if url == "" {
+			return nil, nil, ErrInvalidHost
+		}
+
+		url = fmt.Sprintf(querySitesParams, url, size, num)
+
+		req := s.base.newRequest(ctx, mimeTypeJSON)
+		res, err := s.base.execute(req, http.MethodGet, url, http.StatusOK)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		resBody := models.QuerySiteBody{}
+		if err = json.Unmarshal(res.Body(), &resBody); err != nil {
+			return nil, nil, ErrFailedUnmarshalResponseBody
+		}
+
+		if resBody.Sites == nil {
+			return nil, resBody.Pagination, nil
+		}
+
+		return resBody.Sites.Site, resBody.Pagination, nil
+	})
+}
+
+// UpdateSite Modifies settings for the specified site, including the content URL, administration settings, user and storage quotas,
+// revision history, and whether users can subscribe others. The site must be the signed-in site.
+//
+// URI:
+//
+//	PUT /api/api-version/sites/site-id
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_site.htm#update_site
+func (s *sites) UpdateSite(site *models.Site) (*models.Site, error) {
+	return s.UpdateSiteContext(context.Background(), site)
+}
+
+// UpdateSiteContext Same as UpdateSite, using ctx to cancel the request.
+func (s *sites) UpdateSiteContext(ctx context.Context, site *models.Site) (*models.Site, error) {
+	if !s.base.Authentication.IsSignedIn() {
+		if err := s.base.Authentication.SignInContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if site == nil || site.ID == nil {
+		return nil, ErrBadRequest
+	}
+
+	reqBody := models.SiteBody{Site: newSiteRequest(site)}
+	reqBody.Site.State = site.State
+
+	url := s.base.cfg.GetUrl(fmt.Sprintf(updateSiteUri, *site.ID))
+	if url == "" {
+		return nil, ErrInvalidHost
+	}
+
+	req := retryIdempotent(s.base.newRequest(ctx, mimeTypeJSON).SetBody(reqBody))
+	res, err := s.base.execute(req, http.MethodPut, url, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody := models.SiteBody{}
+	if err = json.Unmarshal(res.Body(), &resBody); err != nil {
+		return nil, ErrFailedUnmarshalResponseBody
+	}
+
+	return resBody.Site, nil
+}
+
+// querySite returns the site identified by key, appending query parameters params into the site URL.
+func (s *sites) querySite(ctx context.Context, key, params string) (*models.Site, error) {
+	if !s.base.Authentication.IsSignedIn() {
+		if err := s.base.Authentication.SignInContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	url := s.base.cfg.GetUrl(fmt.Sprintf(querySiteUri, key))
+	if url == "" {
+		return nil, ErrInvalidHost
+	}
+
+	url = url + params
+
+	req := s.base.newRequest(ctx, mimeTypeJSON)
+	res, err := s.base.execute(req, http.MethodGet, url, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody := models.SiteBody{}
+	if err = json.Unmarshal(res.Body(), &resBody); err != nil {
+		return nil, ErrFailedUnmarshalResponseBody
+	}
+
+	return resBody.Site, nil
+}
+
+// newSiteRequest return site containing only settings accepted by create and update site requests.
+func newSiteRequest(site *models.Site) *models.Site {
+	return &models.Site{
+		Name:                         site.Name,
+		ContentUrl:                   site.ContentUrl,
+		AdminMode:                    site.AdminMode,
+		UserQuota:                    site.UserQuota,
+		StorageQuota:                 site.StorageQuota,
+		TierCreatorCapacity:          site.TierCreatorCapacity,
+		TierExplorerCapacity:         site.TierExplorerCapacity,
+		TierViewerCapacity:           site.TierViewerCapacity,
+		DisableSubscriptions:         site.DisableSubscriptions,
+		SubscribeOthersEnabled:       site.SubscribeOthersEnabled,
+		AllowSubscriptionAttachments: site.AllowSubscriptionAttachments,
+		RevisionHistoryEnabled:       site.RevisionHistoryEnabled,
+		RevisionLimit:                site.RevisionLimit,
+		GuestAccessEnabled:           site.GuestAccessEnabled,
+		CommentingEnabled:            site.CommentingEnabled,
+		CacheWarmupEnabled:           site.CacheWarmupEnabled,
+		ExtractEncryptionMode:        site.ExtractEncryptionMode,
+	}
+}
@@ -0,0 +1,249 @@
+package tableau
+
+import (
+	"context"
+	"fmt"
+	"github.com/tiketdatarisal/tableau/models"
+	"net/http"
+)
+
+type subscriptions struct {
+	base *Client
+}
+
+// CreateSubscription Creates a new subscription to a view or workbook for a specific user.
+// Subject, Content, Schedule and User of the subscription are required,
+// set User ID to the ID of a user returned by UsersGroups methods.
+//
+// URI:
+//
+//	POST /api/api-version/sites/site-id/subscriptions
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_subscriptions.htm#create_subscription
+func (s *subscriptions) CreateSubscription(subscription *models.Subscription) (*models.Subscription, error) {
+	return s.CreateSubscriptionContext(context.Background(), subscription)
+}
+
+// CreateSubscriptionContext Same as CreateSubscription, using ctx to cancel the request.
+func (s *subscriptions) CreateSubscriptionContext(ctx context.Context, subscription *models.Subscription) (*models.Subscription, error) {
+	if !s.base.Authentication.IsSignedIn() {
+		if err := s.base.Authentication.SignInContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if subscription == nil || subscription.Subject == nil ||
+		subscription.Content == nil || subscription.Content.ID == nil || subscription.Content.Type == nil ||
+		subscription.Schedule == nil || subscription.Schedule.ID == nil ||
+		subscription.User == nil || subscription.User.ID == nil {
+		return nil, ErrBadRequest
+	}
+
+	reqBody := models.SubscriptionBody{
+		Subscription: &models.Subscription{
+			Subject:         subscription.Subject,
+			Message:         subscription.Message,
+			AttachImage:     subscription.AttachImage,
+			AttachPdf:       subscription.AttachPdf,
+			PageOrientation: subscription.PageOrientation,
+			PageSizeOption:  subscription.PageSizeOption,
+			Content:         subscription.Content,
+			Schedule:        &models.Schedule{ID: subscription.Schedule.ID},
+			User:            &models.User{ID: subscription.User.ID},
+		},
+	}
+
+	url := s.base.cfg.GetUrl(fmt.Sprintf(createSubscriptionUri, s.base.Authentication.getSiteID()))
+	if url == "" {
+		return nil, ErrInvalidHost
+	}
+
+	req := s.base.newRequest(ctx, mimeTypeJSON).SetBody(reqBody)
+	res, err := s.base.execute(req, http.MethodPost, url, http.StatusCreated)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody := models.SubscriptionBody{}
+	if err = json.Unmarshal(res.Body(), &resBody); err != nil {
+		return nil, ErrFailedUnmarshalResponseBody
+	}
+
+	return resBody.Subscription, nil
+}
+
+// DeleteSubscription Deletes the specified subscription on the specified site.
+//
+// URI:
+//
+//	DELETE /api/api-version/sites/site-id/subscriptions/subscription-id
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_subscriptions.htm#delete_subscription
+func (s *subscriptions) DeleteSubscription(subscriptionID string) error {
+	return s.DeleteSubscriptionContext(context.Background(), subscriptionID)
+}
+
+// DeleteSubscriptionContext Same as DeleteSubscription, using ctx to cancel the request.
+func (s *subscriptions) DeleteSubscriptionContext(ctx context.Context, subscriptionID string) error {
+	if !s.base.Authentication.IsSignedIn() {
+		if err := s.base.Authentication.SignInContext(ctx); err != nil {
+			return err
+		}
+	}
+
+	url := s.base.cfg.GetUrl(fmt.Sprintf(deleteSubscriptionUri, s.base.Authentication.getSiteID(), subscriptionID))
+	if url == "" {
+		return ErrInvalidHost
+	}
+
+	req := s.base.newRequest(ctx, mimeTypeJSON)
+	if _, err := s.base.execute(req, http.MethodDelete, url, http.StatusNoContent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// QuerySubscription Returns information about the specified subscription.
+//
+// URI:
+//
+//	GET /api/api-version/sites/site-id/subscriptions/subscription-id
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_subscriptions.htm#query_subscription
+func (s *subscriptions) QuerySubscription(subscriptionID string) (*models.Subscription, error) {
+	return s.QuerySubscriptionContext(context.Background(), subscriptionID)
+}
+
+// QuerySubscriptionContext Same as QuerySubscription, using ctx to cancel the request.
+func (s *subscriptions) QuerySubscriptionContext(ctx context.Context, subscriptionID string) (*models.Subscription, error) {
+	if !s.base.Authentication.IsSignedIn() {
+		if err := s.base.Authentication.SignInContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	url := s.base.cfg.GetUrl(fmt.Sprintf(querySubscriptionUri, s.base.Authentication.getSiteID(), subscriptionID))
+	if url == "" {
+		return nil, ErrInvalidHost
+	}
+
+	req := s.base.newRequest(ctx, mimeTypeJSON)
+	res, err := s.base.execute(req, http.MethodGet, url, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody := models.SubscriptionBody{}
+	if err = json.Unmarshal(res.Body(), &resBody); err != nil {
+		return nil, ErrFailedUnmarshalResponseBody
+	}
+
+	return resBody.Subscription, nil
+}
+
+// QuerySubscriptions Returns a list of all the subscriptions on the specified site.
+//
+// URI:
+//
+//	GET /api/api-version/sites/site-id/subscriptions
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_subscriptions.htm#query_subscriptions
+func (s *subscriptions) QuerySubscriptions() ([]models.Subscription, error) {
+	return s.QuerySubscriptionsContext(context.Background())
+}
+
+// QuerySubscriptionsContext Same as QuerySubscriptions, using ctx to cancel the requests.
+// No more pages are requested after ctx is done.
+func (s *subscriptions) QuerySubscriptionsContext(ctx context.Context) ([]models.Subscription, error) {
+	return s.QuerySubscriptionsPager(PageOptions{}).All(ctx)
+}
+
+// QuerySubscriptionsPager Same as QuerySubscriptions, returning a pager that requests the subscriptions page by page.
+func (s *subscriptions) QuerySubscriptionsPager(opt PageOptions) *Pager[models.Subscription] {
+	return newPager(s.base, opt, func(ctx context.Context, size, num int) ([]models.Subscription, *models.Pagination, error) {
+		url := s.base.cfg.GetUrl(fmt.Sprintf(querySubscriptionsUri, s.base.Authentication.getSiteID()))
+		if url == "" {
+			return nil, nil, ErrInvalidHost
+		}
+
+		url = fmt.Sprintf(querySubscriptionsParams, url, size, num)
+
+		req := s.base.newRequest(ctx, mimeTypeJSON)
+		res, err := s.base.execute(req, http.MethodGet, url, http.StatusOK)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		resBody := models.QuerySubscriptionBody{}
+		if err = json.Unmarshal(res.Body(), &resBody); err != nil {
+			return nil, nil, ErrFailedUnmarshalResponseBody
+		}
+
+		if resBody.Subscriptions == nil {
+			return nil, resBody.Pagination, nil
+		}
+
+		return resBody.Subscriptions.Subscription, resBody.Pagination, nil
+	})
+}
+
+// UpdateSubscription Modifies an existing subscription, allowing you to change the subject, message, content, schedule,
+// attachments and page settings of the subscription, or suspend it.
+//
+// URI:
+//
+//	PUT /api/api-version/sites/site-id/subscriptions/subscription-id
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_subscriptions.htm#update_subscription
+func (s *subscriptions) UpdateSubscription(subscription *models.Subscription) (*models.Subscription, error) {
+	return s.UpdateSubscriptionContext(context.Background(), subscription)
+}
+
+// UpdateSubscriptionContext Same as UpdateSubscription, using ctx to cancel the request.
+func (s *subscriptions) UpdateSubscriptionContext(ctx context.Context, subscription *models.Subscription) (*models.Subscription, error) {
+	if !s.base.Authentication.IsSignedIn() {
+		if err := s.base.Authentication.SignInContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if subscription == nil || subscription.ID == nil {
+		return nil, ErrBadRequest
+	}
+
+	reqBody := models.SubscriptionBody{
+		Subscription: &models.Subscription{
+			Subject:         subscription.Subject,
+			Message:         subscription.Message,
+			AttachImage:     subscription.AttachImage,
+			AttachPdf:       subscription.AttachPdf,
+			PageOrientation: subscription.PageOrientation,
+			PageSizeOption:  subscription.PageSizeOption,
+			Suspended:       subscription.Suspended,
+			Content:         subscription.Content,
+		},
+	}
+
+	if subscription.Schedule != nil {
+		reqBody.Subscription.Schedule = &models.Schedule{ID: subscription.Schedule.ID}
+	}
+
+	url := s.base.cfg.GetUrl(fmt.Sprintf(updateSubscriptionUri, s.base.Authentication.getSiteID(), *subscription.ID))
+	if url == "" {
+		return nil, ErrInvalidHost
+	}
+
+	req := retryIdempotent(s.base.newRequest(ctx, mimeTypeJSON).SetBody(reqBody))
+	res, err := s.base.execute(req, http.MethodPut, url, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody := models.SubscriptionBody{}
+	if err = json.Unmarshal(res.Body(), &resBody); err != nil {
+		return nil, ErrFailedUnmarshalResponseBody
+	}
+
+	return resBody.Subscription, nil
+}
@@ -0,0 +1,119 @@
+package tableau
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/tiketdatarisal/tableau/models"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+)
+
+// filePart is the file attached into a multipart/mixed request body.
+type filePart struct {
+	field string
+	name  string
+	data  []byte
+}
+
+// newMultipartMixed build multipart/mixed request body containing request payload followed by optional file,
+// and return the body with its content type.
+func newMultipartMixed(payload any, file *filePart) ([]byte, string, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, "", err
+	}
+
+	body := &bytes.Buffer{}
+	mw := multipart.NewWriter(body)
+
+	header := textproto.MIMEHeader{}
+	header.Set(contentDispositionHeader, `name="request_payload"`)
+	header.Set(contentTypeHeader, mimeTypeJSON)
+
+	part, err := mw.CreatePart(header)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if _, err = part.Write(data); err != nil {
+		return nil, "", err
+	}
+
+	if file != nil {
+		header = textproto.MIMEHeader{}
+		header.Set(contentDispositionHeader, fmt.Sprintf(`name=%q; filename=%q`, file.field, file.name))
+		header.Set(contentTypeHeader, mimeTypeOctetStream)
+
+		if part, err = mw.CreatePart(header); err != nil {
+			return nil, "", err
+		}
+
+		if _, err = part.Write(file.data); err != nil {
+			return nil, "", err
+		}
+	}
+
+	if err = mw.Close(); err != nil {
+		return nil, "", err
+	}
+
+	return body.Bytes(), fmt.Sprintf(mimeTypeMultipartMixed, mw.Boundary()), nil
+}
+
+// uploadFile uploads file using a new file upload session in chunks, and return the upload session ID.
+// The upload session ID can be used to commit the upload by publish methods.
+// Chunks are never retried, as a resent chunk would be appended twice.
+func (c *Client) uploadFile(ctx context.Context, file io.Reader) (string, error) {
+	url := c.cfg.GetUrl(fmt.Sprintf(initiateFileUploadUri, c.Authentication.getSiteID()))
+	if url == "" {
+		return "", ErrInvalidHost
+	}
+
+	req := c.newRequest(ctx, mimeTypeJSON)
+	res, err := c.execute(req, http.MethodPost, url, http.StatusCreated)
+	if err != nil {
+		return "", err
+	}
+
+	resBody := models.FileUploadBody{}
+	if err = json.Unmarshal(res.Body(), &resBody); err != nil || resBody.FileUpload == nil || resBody.FileUpload.UploadSessionID == nil {
+		return "", ErrFailedUnmarshalResponseBody
+	}
+
+	sessionID := *resBody.FileUpload.UploadSessionID
+	url = c.cfg.GetUrl(fmt.Sprintf(appendToFileUploadUri, c.Authentication.getSiteID(), sessionID))
+	if url == "" {
+		return "", ErrInvalidHost
+	}
+
+	chunk := make([]byte, fileUploadChunkSize)
+	for {
+		n, readErr := io.ReadFull(file, chunk)
+		if n > 0 {
+			body, contentType, err := newMultipartMixed(struct{}{}, &filePart{field: "tableau_file", name: "file", data: chunk[:n]})
+			if err != nil {
+				return "", err
+			}
+
+			req = c.newRequest(ctx, mimeTypeJSON).
+				SetHeader(contentTypeHeader, contentType).
+				SetBody(body)
+
+			if _, err = c.execute(req, http.MethodPut, url, http.StatusOK); err != nil {
+				return "", err
+			}
+		}
+
+		if errors.Is(readErr, io.EOF) || errors.Is(readErr, io.ErrUnexpectedEOF) {
+			return sessionID, nil
+		}
+
+		if readErr != nil {
+			return "", readErr
+		}
+	}
+}
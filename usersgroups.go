@@ -1,6 +1,7 @@
 package tableau
 
 import (
+	"context"
 	"fmt"
 	"github.com/tiketdatarisal/tableau/models"
 	"net/http"
@@ -20,8 +21,13 @@ type usersGroups struct {
 //
 // Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_users_and_groups.htm#add_user_to_group
 func (u *usersGroups) AddUserToGroup(userID, groupID string) (*models.User, error) {
+	return u.AddUserToGroupContext(context.Background(), userID, groupID)
+}
+
+// AddUserToGroupContext Same as AddUserToGroup, using ctx to cancel the request.
+func (u *usersGroups) AddUserToGroupContext(ctx context.Context, userID, groupID string) (*models.User, error) {
 	if !u.base.Authentication.IsSignedIn() {
-		if err := u.base.Authentication.SignIn(); err != nil {
+		if err := u.base.Authentication.SignInContext(ctx); err != nil {
 			return nil, err
 		}
 	}
@@ -32,35 +38,15 @@ func (u *usersGroups) AddUserToGroup(userID, groupID string) (*models.User, erro
 		},
 	}
 
-	url := u.base.cfg.GetUrl(fmt.Sprintf(addUserToGroupUri, u.base.Authentication.siteID, groupID))
+	url := u.base.cfg.GetUrl(fmt.Sprintf(addUserToGroupUri, u.base.Authentication.getSiteID(), groupID))
 	if url == "" {
 		return nil, ErrInvalidHost
 	}
 
-	res, err := u.base.c.R().
-		SetHeader(contentTypeHeader, mimeTypeJSON).
-		SetHeader(acceptHeader, mimeTypeJSON).
-		SetHeader(authorizationHeader, u.base.Authentication.getBearerToken()).
-		SetBody(reqBody).
-		Post(url)
-
-	u.base.SetResponse(*res)
+	req := u.base.newRequest(ctx, mimeTypeJSON).SetBody(reqBody)
+	res, err := u.base.execute(req, http.MethodPost, url, http.StatusOK)
 	if err != nil {
-		errBody, err := models.NewErrorBody(res.Body())
-		if err != nil {
-			return nil, ErrUnknownError
-		}
-
-		return nil, errBody.Error
-	}
-
-	if res.StatusCode() != http.StatusOK {
-		errBody, err := models.NewErrorBody(res.Body())
-		if err != nil {
-			return nil, ErrUnknownError
-		}
-
-		return nil, errBody.Error
+		return nil, err
 	}
 
 	resBody := models.UserBody{}
@@ -83,8 +69,13 @@ func (u *usersGroups) AddUserToGroup(userID, groupID string) (*models.User, erro
 //
 // Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_users_and_groups.htm#add_user_to_site
 func (u *usersGroups) AddUserToSite(user *models.User) (*models.User, error) {
+	return u.AddUserToSiteContext(context.Background(), user)
+}
+
+// AddUserToSiteContext Same as AddUserToSite, using ctx to cancel the request.
+func (u *usersGroups) AddUserToSiteContext(ctx context.Context, user *models.User) (*models.User, error) {
 	if !u.base.Authentication.IsSignedIn() {
-		if err := u.base.Authentication.SignIn(); err != nil {
+		if err := u.base.Authentication.SignInContext(ctx); err != nil {
 			return nil, err
 		}
 	}
@@ -100,35 +91,15 @@ func (u *usersGroups) AddUserToSite(user *models.User) (*models.User, error) {
 		},
 	}
 
-	url := u.base.cfg.GetUrl(fmt.Sprintf(addUserToSiteUri, u.base.Authentication.siteID))
+	url := u.base.cfg.GetUrl(fmt.Sprintf(addUserToSiteUri, u.base.Authentication.getSiteID()))
 	if url == "" {
 		return nil, ErrInvalidHost
 	}
 
-	res, err := u.base.c.R().
-		SetHeader(contentTypeHeader, mimeTypeJSON).
-		SetHeader(acceptHeader, mimeTypeJSON).
-		SetHeader(authorizationHeader, u.base.Authentication.getBearerToken()).
-		SetBody(reqBody).
-		Post(url)
-
-	u.base.SetResponse(*res)
+	req := u.base.newRequest(ctx, mimeTypeJSON).SetBody(reqBody)
+	res, err := u.base.execute(req, http.MethodPost, url, http.StatusCreated)
 	if err != nil {
-		errBody, err := models.NewErrorBody(res.Body())
-		if err != nil {
-			return nil, ErrUnknownError
-		}
-
-		return nil, errBody.Error
-	}
-
-	if res.StatusCode() != http.StatusCreated {
-		errBody, err := models.NewErrorBody(res.Body())
-		if err != nil {
-			return nil, ErrUnknownError
-		}
-
-		return nil, errBody.Error
+		return nil, err
 	}
 
 	resBody := models.UserBody{}
@@ -147,8 +118,13 @@ func (u *usersGroups) AddUserToSite(user *models.User) (*models.User, error) {
 //
 // Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_users_and_groups.htm#create_group
 func (u *usersGroups) CreateGroup(group *models.Group) (*models.Group, error) {
+	return u.CreateGroupContext(context.Background(), group)
+}
+
+// CreateGroupContext Same as CreateGroup, using ctx to cancel the request.
+func (u *usersGroups) CreateGroupContext(ctx context.Context, group *models.Group) (*models.Group, error) {
 	if !u.base.Authentication.IsSignedIn() {
-		if err := u.base.Authentication.SignIn(); err != nil {
+		if err := u.base.Authentication.SignInContext(ctx); err != nil {
 			return nil, err
 		}
 	}
@@ -168,35 +144,15 @@ func (u *usersGroups) CreateGroup(group *models.Group) (*models.Group, error) {
 		reqBody.Group.Import = group.Import
 	}
 
-	url := u.base.cfg.GetUrl(fmt.Sprintf(createGroupUri, u.base.Authentication.siteID))
+	url := u.base.cfg.GetUrl(fmt.Sprintf(createGroupUri, u.base.Authentication.getSiteID()))
 	if url == "" {
 		return nil, ErrInvalidHost
 	}
 
-	res, err := u.base.c.R().
-		SetHeader(contentTypeHeader, mimeTypeJSON).
-		SetHeader(acceptHeader, mimeTypeJSON).
-		SetHeader(authorizationHeader, u.base.Authentication.getBearerToken()).
-		SetBody(reqBody).
-		Post(url)
-
-	u.base.SetResponse(*res)
+	req := u.base.newRequest(ctx, mimeTypeJSON).SetBody(reqBody)
+	res, err := u.base.execute(req, http.MethodPost, url, http.StatusCreated, http.StatusAccepted)
 	if err != nil {
-		errBody, err := models.NewErrorBody(res.Body())
-		if err != nil {
-			return nil, ErrUnknownError
-		}
-
-		return nil, errBody.Error
-	}
-
-	if res.StatusCode() != http.StatusCreated && res.StatusCode() != http.StatusAccepted {
-		errBody, err := models.NewErrorBody(res.Body())
-		if err != nil {
-			return nil, ErrUnknownError
-		}
-
-		return nil, errBody.Error
+		return nil, err
 	}
 
 	resBody := models.GroupBody{}
@@ -217,40 +173,25 @@ func (u *usersGroups) CreateGroup(group *models.Group) (*models.Group, error) {
 //
 // Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_users_and_groups.htm#delete_group
 func (u *usersGroups) DeleteGroup(groupID string) error {
+	return u.DeleteGroupContext(context.Background(), groupID)
+}
+
+// DeleteGroupContext Same as DeleteGroup, using ctx to cancel the request.
+func (u *usersGroups) DeleteGroupContext(ctx context.Context, groupID string) error {
 	if !u.base.Authentication.IsSignedIn() {
-		if err := u.base.Authentication.SignIn(); err != nil {
+		if err := u.base.Authentication.SignInContext(ctx); err != nil {
 			return err
 		}
 	}
 
-	url := u.base.cfg.GetUrl(fmt.Sprintf(deleteGroupUri, u.base.Authentication.siteID, groupID))
+	url := u.base.cfg.GetUrl(fmt.Sprintf(deleteGroupUri, u.base.Authentication.getSiteID(), groupID))
 	if url == "" {
 		return ErrInvalidHost
 	}
 
-	res, err := u.base.c.R().
-		SetHeader(contentTypeHeader, mimeTypeJSON).
-		SetHeader(acceptHeader, mimeTypeJSON).
-		SetHeader(authorizationHeader, u.base.Authentication.getBearerToken()).
-		Delete(url)
-
-	u.base.SetResponse(*res)
-	if err != nil {
-		errBody, err := models.NewErrorBody(res.Body())
-		if err != nil {
-			return ErrUnknownError
-		}
-
-		return errBody.Error
-	}
-
-	if res.StatusCode() != http.StatusNoContent {
-		errBody, err := models.NewErrorBody(res.Body())
-		if err != nil {
-			return ErrUnknownError
-		}
-
-		return errBody.Error
+	req := u.base.newRequest(ctx, mimeTypeJSON)
+	if _, err := u.base.execute(req, http.MethodDelete, url, http.StatusNoContent); err != nil {
+		return err
 	}
 
 	return nil
@@ -264,61 +205,41 @@ func (u *usersGroups) DeleteGroup(groupID string) error {
 //
 // Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_users_and_groups.htm#get_groups_for_a_user
 func (u *usersGroups) GetGroupsForUser(userID string) ([]models.Group, error) {
-	if !u.base.Authentication.IsSignedIn() {
-		if err := u.base.Authentication.SignIn(); err != nil {
-			return nil, err
-		}
-	}
+	return u.GetGroupsForUserContext(context.Background(), userID)
+}
 
-	query := ""
-	pageNum := 1
-	var result []models.Group
-	for {
-		url := u.base.cfg.GetUrl(fmt.Sprintf(getGroupsForUserUri, u.base.Authentication.siteID, userID))
+// GetGroupsForUserContext Same as GetGroupsForUser, using ctx to cancel the requests.
+// No more pages are requested after ctx is done.
+func (u *usersGroups) GetGroupsForUserContext(ctx context.Context, userID string) ([]models.Group, error) {
+	return u.GetGroupsForUserPager(PageOptions{}, userID).All(ctx)
+}
+
+// GetGroupsForUserPager Same as GetGroupsForUser, returning a pager that requests the groups page by page.
+func (u *usersGroups) GetGroupsForUserPager(opt PageOptions, userID string) *Pager[models.Group] {
+	return newPager(u.base, opt, func(ctx context.Context, size, num int) ([]models.Group, *models.Pagination, error) {
+		url := u.base.cfg.GetUrl(fmt.Sprintf(getGroupsForUserUri, u.base.Authentication.getSiteID(), userID))
 		if url == "" {
-			return nil, ErrInvalidHost
+			return nil, nil, ErrInvalidHost
 		}
 
-		url = fmt.Sprintf(pagingParams, url, pageSize, pageNum, query)
-		res, err := u.base.c.R().
-			SetHeader(contentTypeHeader, mimeTypeJSON).
-			SetHeader(acceptHeader, mimeTypeJSON).
-			SetHeader(authorizationHeader, u.base.Authentication.getBearerToken()).
-			Get(url)
-
-		u.base.SetResponse(*res)
+		url = fmt.Sprintf(pagingParams, url, size, num, "")
+		req := u.base.newRequest(ctx, mimeTypeJSON)
+		res, err := u.base.execute(req, http.MethodGet, url, http.StatusOK)
 		if err != nil {
-			errBody, err := models.NewErrorBody(res.Body())
-			if err != nil {
-				return nil, ErrUnknownError
-			}
-
-			return nil, errBody.Error
-		}
-
-		if res.StatusCode() != http.StatusOK {
-			errBody, err := models.NewErrorBody(res.Body())
-			if err != nil {
-				return nil, ErrUnknownError
-			}
-
-			return nil, errBody.Error
+			return nil, nil, err
 		}
 
 		resBody := models.QueryGroupBody{}
 		if err = json.Unmarshal(res.Body(), &resBody); err != nil {
-			return nil, ErrFailedUnmarshalResponseBody
+			return nil, nil, ErrFailedUnmarshalResponseBody
 		}
 
-		result = append(result, resBody.Groups.Group...)
-		if pageNum*pageSize >= resBody.Pagination.GetTotalAvailable() {
-			break
+		if resBody.Groups == nil {
+			return nil, resBody.Pagination, nil
 		}
 
-		pageNum++
-	}
-
-	return result, nil
+		return resBody.Groups.Group, resBody.Pagination, nil
+	})
 }
 
 // GetUsersInGroup Gets a list of users in the specified group.
@@ -329,61 +250,41 @@ func (u *usersGroups) GetGroupsForUser(userID string) ([]models.Group, error) {
 //
 // Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_users_and_groups.htm#get_users_in_group
 func (u *usersGroups) GetUsersInGroup(groupID string) ([]models.User, error) {
-	if !u.base.Authentication.IsSignedIn() {
-		if err := u.base.Authentication.SignIn(); err != nil {
-			return nil, err
-		}
-	}
+	return u.GetUsersInGroupContext(context.Background(), groupID)
+}
 
-	query := ""
-	pageNum := 1
-	var result []models.User
-	for {
-		url := u.base.cfg.GetUrl(fmt.Sprintf(getUsersInGroupUri, u.base.Authentication.siteID, groupID))
+// GetUsersInGroupContext Same as GetUsersInGroup, using ctx to cancel the requests.
+// No more pages are requested after ctx is done.
+func (u *usersGroups) GetUsersInGroupContext(ctx context.Context, groupID string) ([]models.User, error) {
+	return u.GetUsersInGroupPager(PageOptions{}, groupID).All(ctx)
+}
+
+// GetUsersInGroupPager Same as GetUsersInGroup, returning a pager that requests the users page by page.
+func (u *usersGroups) GetUsersInGroupPager(opt PageOptions, groupID string) *Pager[models.User] {
+	return newPager(u.base, opt, func(ctx context.Context, size, num int) ([]models.User, *models.Pagination, error) {
+		url := u.base.cfg.GetUrl(fmt.Sprintf(getUsersInGroupUri, u.base.Authentication.getSiteID(), groupID))
 		if url == "" {
-			return nil, ErrInvalidHost
+			return nil, nil, ErrInvalidHost
 		}
 
-		url = fmt.Sprintf(pagingParams, url, pageSize, pageNum, query)
-		res, err := u.base.c.R().
-			SetHeader(contentTypeHeader, mimeTypeJSON).
-			SetHeader(acceptHeader, mimeTypeJSON).
-			SetHeader(authorizationHeader, u.base.Authentication.getBearerToken()).
-			Get(url)
-
-		u.base.SetResponse(*res)
+		url = fmt.Sprintf(pagingParams, url, size, num, "")
+		req := u.base.newRequest(ctx, mimeTypeJSON)
+		res, err := u.base.execute(req, http.MethodGet, url, http.StatusOK)
 		if err != nil {
-			errBody, err := models.NewErrorBody(res.Body())
-			if err != nil {
-				return nil, ErrUnknownError
-			}
-
-			return nil, errBody.Error
-		}
-
-		if res.StatusCode() != http.StatusOK {
-			errBody, err := models.NewErrorBody(res.Body())
-			if err != nil {
-				return nil, ErrUnknownError
-			}
-
-			return nil, errBody.Error
+			return nil, nil, err
 		}
 
 		resBody := models.QueryUserBody{}
 		if err = json.Unmarshal(res.Body(), &resBody); err != nil {
-			return nil, ErrFailedUnmarshalResponseBody
+			return nil, nil, ErrFailedUnmarshalResponseBody
 		}
 
-		result = append(result, resBody.Users.User...)
-		if pageNum*pageSize >= resBody.Pagination.GetTotalAvailable() {
-			break
+		if resBody.Users == nil {
+			return nil, resBody.Pagination, nil
 		}
 
-		pageNum++
-	}
-
-	return result, nil
+		return resBody.Users.User, resBody.Pagination, nil
+	})
 }
 
 // GetUsersOnSite Returns the users associated with the specified site.
@@ -394,12 +295,17 @@ func (u *usersGroups) GetUsersInGroup(groupID string) ([]models.User, error) {
 //
 // Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_users_and_groups.htm#get_users_on_site
 func (u *usersGroups) GetUsersOnSite(userNames ...string) ([]models.User, error) {
-	if !u.base.Authentication.IsSignedIn() {
-		if err := u.base.Authentication.SignIn(); err != nil {
-			return nil, err
-		}
-	}
+	return u.GetUsersOnSiteContext(context.Background(), userNames...)
+}
 
+// GetUsersOnSiteContext Same as GetUsersOnSite, using ctx to cancel the requests.
+// No more pages are requested after ctx is done.
+func (u *usersGroups) GetUsersOnSiteContext(ctx context.Context, userNames ...string) ([]models.User, error) {
+	return u.GetUsersOnSitePager(PageOptions{}, userNames...).All(ctx)
+}
+
+// GetUsersOnSitePager Same as GetUsersOnSite, returning a pager that requests the users page by page.
+func (u *usersGroups) GetUsersOnSitePager(opt PageOptions, userNames ...string) *Pager[models.User] {
 	query := ""
 	if len(userNames) > 0 {
 		query = fmt.Sprintf(
@@ -411,54 +317,30 @@ func (u *usersGroups) GetUsersOnSite(userNames ...string) ([]models.User, error)
 				-1))
 	}
 
-	pageNum := 1
-	var result []models.User
-	for {
-		url := u.base.cfg.GetUrl(fmt.Sprintf(getUsersOnSiteUri, u.base.Authentication.siteID))
+	return newPager(u.base, opt, func(ctx context.Context, size, num int) ([]models.User, *models.Pagination, error) {
+		url := u.base.cfg.GetUrl(fmt.Sprintf(getUsersOnSiteUri, u.base.Authentication.getSiteID()))
 		if url == "" {
-			return nil, ErrInvalidHost
+			return nil, nil, ErrInvalidHost
 		}
 
-		url = fmt.Sprintf(pagingParams, url, pageSize, pageNum, query)
-		res, err := u.base.c.R().
-			SetHeader(contentTypeHeader, mimeTypeJSON).
-			SetHeader(acceptHeader, mimeTypeJSON).
-			SetHeader(authorizationHeader, u.base.Authentication.getBearerToken()).
-			Get(url)
-
-		u.base.SetResponse(*res)
+		url = fmt.Sprintf(pagingParams, url, size, num, query)
+		req := u.base.newRequest(ctx, mimeTypeJSON)
+		res, err := u.base.execute(req, http.MethodGet, url, http.StatusOK)
 		if err != nil {
-			errBody, err := models.NewErrorBody(res.Body())
-			if err != nil {
-				return nil, ErrUnknownError
-			}
-
-			return nil, errBody.Error
-		}
-
-		if res.StatusCode() != http.StatusOK {
-			errBody, err := models.NewErrorBody(res.Body())
-			if err != nil {
-				return nil, ErrUnknownError
-			}
-
-			return nil, errBody.Error
+			return nil, nil, err
 		}
 
 		resBody := models.QueryUserBody{}
 		if err = json.Unmarshal(res.Body(), &resBody); err != nil {
-			return nil, ErrFailedUnmarshalResponseBody
+			return nil, nil, ErrFailedUnmarshalResponseBody
 		}
 
-		result = append(result, resBody.Users.User...)
-		if pageNum*pageSize >= resBody.Pagination.GetTotalAvailable() {
-			break
+		if resBody.Users == nil {
+			return nil, resBody.Pagination, nil
 		}
 
-		pageNum++
-	}
-
-	return result, nil
+		return resBody.Users.User, resBody.Pagination, nil
+	})
 }
 
 // QueryGroups Returns a list of groups on the specified site, with optional parameters for specifying the paging of large results.
@@ -469,12 +351,17 @@ func (u *usersGroups) GetUsersOnSite(userNames ...string) ([]models.User, error)
 //
 // Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_users_and_groups.htm#query_groups
 func (u *usersGroups) QueryGroups(groupNames ...string) ([]models.Group, error) {
-	if !u.base.Authentication.IsSignedIn() {
-		if err := u.base.Authentication.SignIn(); err != nil {
-			return nil, err
-		}
-	}
+	return u.QueryGroupsContext(context.Background(), groupNames...)
+}
+
+// QueryGroupsContext Same as QueryGroups, using ctx to cancel the requests.
+// No more pages are requested after ctx is done.
+func (u *usersGroups) QueryGroupsContext(ctx context.Context, groupNames ...string) ([]models.Group, error) {
+	return u.QueryGroupsPager(PageOptions{}, groupNames...).All(ctx)
+}
 
+// QueryGroupsPager Same as QueryGroups, returning a pager that requests the groups page by page.
+func (u *usersGroups) QueryGroupsPager(opt PageOptions, groupNames ...string) *Pager[models.Group] {
 	query := ""
 	if len(groupNames) > 0 {
 		query = fmt.Sprintf(
@@ -486,54 +373,30 @@ func (u *usersGroups) QueryGroups(groupNames ...string) ([]models.Group, error)
 				-1))
 	}
 
-	pageNum := 1
-	var result []models.Group
-	for {
-		url := u.base.cfg.GetUrl(fmt.Sprintf(queryGroupsUri, u.base.Authentication.siteID))
+	return newPager(u.base, opt, func(ctx context.Context, size, num int) ([]models.Group, *models.Pagination, error) {
+		url := u.base.cfg.GetUrl(fmt.Sprintf(queryGroupsUri, u.base.Authentication.getSiteID()))
 		if url == "" {
-			return nil, ErrInvalidHost
+			return nil, nil, ErrInvalidHost
 		}
 
-		url = fmt.Sprintf(pagingParams, url, pageSize, pageNum, query)
-		res, err := u.base.c.R().
-			SetHeader(contentTypeHeader, mimeTypeJSON).
-			SetHeader(acceptHeader, mimeTypeJSON).
-			SetHeader(authorizationHeader, u.base.Authentication.getBearerToken()).
-			Get(url)
-
-		u.base.SetResponse(*res)
+		url = fmt.Sprintf(pagingParams, url, size, num, query)
+		req := u.base.newRequest(ctx, mimeTypeJSON)
+		res, err := u.base.execute(req, http.MethodGet, url, http.StatusOK)
 		if err != nil {
-			errBody, err := models.NewErrorBody(res.Body())
-			if err != nil {
-				return nil, ErrUnknownError
-			}
-
-			return nil, errBody.Error
-		}
-
-		if res.StatusCode() != http.StatusOK {
-			errBody, err := models.NewErrorBody(res.Body())
-			if err != nil {
-				return nil, ErrUnknownError
-			}
-
-			return nil, errBody.Error
+			return nil, nil, err
 		}
 
 		resBody := models.QueryGroupBody{}
 		if err = json.Unmarshal(res.Body(), &resBody); err != nil {
-			return nil, ErrFailedUnmarshalResponseBody
+			return nil, nil, ErrFailedUnmarshalResponseBody
 		}
 
-		result = append(result, resBody.Groups.Group...)
-		if pageNum*pageSize >= resBody.Pagination.GetTotalAvailable() {
-			break
+		if resBody.Groups == nil {
+			return nil, resBody.Pagination, nil
 		}
 
-		pageNum++
-	}
-
-	return result, nil
+		return resBody.Groups.Group, resBody.Pagination, nil
+	})
 }
 
 // QueryUserOnSite Returns information about the specified user.
@@ -544,40 +407,26 @@ func (u *usersGroups) QueryGroups(groupNames ...string) ([]models.Group, error)
 //
 // Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_users_and_groups.htm#query_user_on_site
 func (u *usersGroups) QueryUserOnSite(userID string) (*models.User, error) {
+	return u.QueryUserOnSiteContext(context.Background(), userID)
+}
+
+// QueryUserOnSiteContext Same as QueryUserOnSite, using ctx to cancel the request.
+func (u *usersGroups) QueryUserOnSiteContext(ctx context.Context, userID string) (*models.User, error) {
 	if !u.base.Authentication.IsSignedIn() {
-		if err := u.base.Authentication.SignIn(); err != nil {
+		if err := u.base.Authentication.SignInContext(ctx); err != nil {
 			return nil, err
 		}
 	}
 
-	url := u.base.cfg.GetUrl(fmt.Sprintf(queryUserOnSiteUri, u.base.Authentication.siteID, userID))
+	url := u.base.cfg.GetUrl(fmt.Sprintf(queryUserOnSiteUri, u.base.Authentication.getSiteID(), userID))
 	if url == "" {
 		return nil, ErrInvalidHost
 	}
 
-	res, err := u.base.c.R().
-		SetHeader(contentTypeHeader, mimeTypeJSON).
-		SetHeader(acceptHeader, mimeTypeJSON).
-		SetHeader(authorizationHeader, u.base.Authentication.getBearerToken()).
-		Get(url)
-
-	u.base.SetResponse(*res)
+	req := u.base.newRequest(ctx, mimeTypeJSON)
+	res, err := u.base.execute(req, http.MethodGet, url, http.StatusOK)
 	if err != nil {
-		errBody, err := models.NewErrorBody(res.Body())
-		if err != nil {
-			return nil, ErrUnknownError
-		}
-
-		return nil, errBody.Error
-	}
-
-	if res.StatusCode() != http.StatusOK {
-		errBody, err := models.NewErrorBody(res.Body())
-		if err != nil {
-			return nil, ErrUnknownError
-		}
-
-		return nil, errBody.Error
+		return nil, err
 	}
 
 	resBody := models.UserBody{}
@@ -603,13 +452,18 @@ func (u *usersGroups) QueryUserOnSite(userID string) (*models.User, error) {
 //
 // Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_users_and_groups.htm#remove_user_from_site
 func (u *usersGroups) RemoveUserFromSite(userID string, newUserID ...string) error {
+	return u.RemoveUserFromSiteContext(context.Background(), userID, newUserID...)
+}
+
+// RemoveUserFromSiteContext Same as RemoveUserFromSite, using ctx to cancel the request.
+func (u *usersGroups) RemoveUserFromSiteContext(ctx context.Context, userID string, newUserID ...string) error {
 	if !u.base.Authentication.IsSignedIn() {
-		if err := u.base.Authentication.SignIn(); err != nil {
+		if err := u.base.Authentication.SignInContext(ctx); err != nil {
 			return err
 		}
 	}
 
-	url := u.base.cfg.GetUrl(fmt.Sprintf(removeUserFromSiteUri, u.base.Authentication.siteID, userID))
+	url := u.base.cfg.GetUrl(fmt.Sprintf(removeUserFromSiteUri, u.base.Authentication.getSiteID(), userID))
 	if url == "" {
 		return ErrInvalidHost
 	}
@@ -618,29 +472,9 @@ func (u *usersGroups) RemoveUserFromSite(userID string, newUserID ...string) err
 		url = fmt.Sprintf(mapAssetsParams, url, newUserID[0])
 	}
 
-	res, err := u.base.c.R().
-		SetHeader(contentTypeHeader, mimeTypeJSON).
-		SetHeader(acceptHeader, mimeTypeJSON).
-		SetHeader(authorizationHeader, u.base.Authentication.getBearerToken()).
-		Delete(url)
-
-	u.base.SetResponse(*res)
-	if err != nil {
-		errBody, err := models.NewErrorBody(res.Body())
-		if err != nil {
-			return ErrUnknownError
-		}
-
-		return errBody.Error
-	}
-
-	if res.StatusCode() != http.StatusNoContent {
-		errBody, err := models.NewErrorBody(res.Body())
-		if err != nil {
-			return ErrUnknownError
-		}
-
-		return errBody.Error
+	req := u.base.newRequest(ctx, mimeTypeJSON)
+	if _, err := u.base.execute(req, http.MethodDelete, url, http.StatusNoContent); err != nil {
+		return err
 	}
 
 	return nil
@@ -654,40 +488,25 @@ func (u *usersGroups) RemoveUserFromSite(userID string, newUserID ...string) err
 //
 // Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_users_and_groups.htm#remove_user_to_group
 func (u *usersGroups) RemoveUserFromGroup(userID, groupID string) error {
+	return u.RemoveUserFromGroupContext(context.Background(), userID, groupID)
+}
+
+// RemoveUserFromGroupContext Same as RemoveUserFromGroup, using ctx to cancel the request.
+func (u *usersGroups) RemoveUserFromGroupContext(ctx context.Context, userID, groupID string) error {
 	if !u.base.Authentication.IsSignedIn() {
-		if err := u.base.Authentication.SignIn(); err != nil {
+		if err := u.base.Authentication.SignInContext(ctx); err != nil {
 			return err
 		}
 	}
 
-	url := u.base.cfg.GetUrl(fmt.Sprintf(removeUserFromGroupUri, u.base.Authentication.siteID, groupID, userID))
+	url := u.base.cfg.GetUrl(fmt.Sprintf(removeUserFromGroupUri, u.base.Authentication.getSiteID(), groupID, userID))
 	if url == "" {
 		return ErrInvalidHost
 	}
 
-	res, err := u.base.c.R().
-		SetHeader(contentTypeHeader, mimeTypeJSON).
-		SetHeader(acceptHeader, mimeTypeJSON).
-		SetHeader(authorizationHeader, u.base.Authentication.getBearerToken()).
-		Delete(url)
-
-	u.base.SetResponse(*res)
-	if err != nil {
-		errBody, err := models.NewErrorBody(res.Body())
-		if err != nil {
-			return ErrUnknownError
-		}
-
-		return errBody.Error
-	}
-
-	if res.StatusCode() != http.StatusNoContent {
-		errBody, err := models.NewErrorBody(res.Body())
-		if err != nil {
-			return ErrUnknownError
-		}
-
-		return errBody.Error
+	req := u.base.newRequest(ctx, mimeTypeJSON)
+	if _, err := u.base.execute(req, http.MethodDelete, url, http.StatusNoContent); err != nil {
+		return err
 	}
 
 	return nil
@@ -702,8 +521,13 @@ func (u *usersGroups) RemoveUserFromGroup(userID, groupID string) error {
 //
 // Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_users_and_groups.htm#update_group
 func (u *usersGroups) UpdateGroup(group *models.Group) (*models.Group, error) {
+	return u.UpdateGroupContext(context.Background(), group)
+}
+
+// UpdateGroupContext Same as UpdateGroup, using ctx to cancel the request.
+func (u *usersGroups) UpdateGroupContext(ctx context.Context, group *models.Group) (*models.Group, error) {
 	if !u.base.Authentication.IsSignedIn() {
-		if err := u.base.Authentication.SignIn(); err != nil {
+		if err := u.base.Authentication.SignInContext(ctx); err != nil {
 			return nil, err
 		}
 	}
@@ -723,35 +547,15 @@ func (u *usersGroups) UpdateGroup(group *models.Group) (*models.Group, error) {
 		reqBody.Group.Import = group.Import
 	}
 
-	url := u.base.cfg.GetUrl(fmt.Sprintf(updateGroupUri, u.base.Authentication.siteID, *group.ID))
+	url := u.base.cfg.GetUrl(fmt.Sprintf(updateGroupUri, u.base.Authentication.getSiteID(), *group.ID))
 	if url == "" {
 		return nil, ErrInvalidHost
 	}
 
-	res, err := u.base.c.R().
-		SetHeader(contentTypeHeader, mimeTypeJSON).
-		SetHeader(acceptHeader, mimeTypeJSON).
-		SetHeader(authorizationHeader, u.base.Authentication.getBearerToken()).
-		SetBody(reqBody).
-		Put(url)
-
-	u.base.SetResponse(*res)
+	req := u.base.newRequest(ctx, mimeTypeJSON).SetBody(reqBody)
+	res, err := u.base.execute(req, http.MethodPut, url, http.StatusOK, http.StatusAccepted)
 	if err != nil {
-		errBody, err := models.NewErrorBody(res.Body())
-		if err != nil {
-			return nil, ErrUnknownError
-		}
-
-		return nil, errBody.Error
-	}
-
-	if res.StatusCode() != http.StatusOK && res.StatusCode() != http.StatusAccepted {
-		errBody, err := models.NewErrorBody(res.Body())
-		if err != nil {
-			return nil, ErrUnknownError
-		}
-
-		return nil, errBody.Error
+		return nil, err
 	}
 
 	resBody := models.GroupBody{}
@@ -772,8 +576,13 @@ func (u *usersGroups) UpdateGroup(group *models.Group) (*models.Group, error) {
 //
 // Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_users_and_groups.htm#update_user
 func (u *usersGroups) UpdateUser(user *models.User) (*models.User, error) {
+	return u.UpdateUserContext(context.Background(), user)
+}
+
+// UpdateUserContext Same as UpdateUser, using ctx to cancel the request.
+func (u *usersGroups) UpdateUserContext(ctx context.Context, user *models.User) (*models.User, error) {
 	if !u.base.Authentication.IsSignedIn() {
-		if err := u.base.Authentication.SignIn(); err != nil {
+		if err := u.base.Authentication.SignInContext(ctx); err != nil {
 			return nil, err
 		}
 	}
@@ -792,35 +601,15 @@ func (u *usersGroups) UpdateUser(user *models.User) (*models.User, error) {
 		},
 	}
 
-	url := u.base.cfg.GetUrl(fmt.Sprintf(updateUserUri, u.base.Authentication.siteID, *user.ID))
+	url := u.base.cfg.GetUrl(fmt.Sprintf(updateUserUri, u.base.Authentication.getSiteID(), *user.ID))
 	if url == "" {
 		return nil, ErrInvalidHost
 	}
 
-	res, err := u.base.c.R().
-		SetHeader(contentTypeHeader, mimeTypeJSON).
-		SetHeader(acceptHeader, mimeTypeJSON).
-		SetHeader(authorizationHeader, u.base.Authentication.getBearerToken()).
-		SetBody(reqBody).
-		Put(url)
-
-	u.base.SetResponse(*res)
+	req := retryIdempotent(u.base.newRequest(ctx, mimeTypeJSON).SetBody(reqBody))
+	res, err := u.base.execute(req, http.MethodPut, url, http.StatusOK)
 	if err != nil {
-		errBody, err := models.NewErrorBody(res.Body())
-		if err != nil {
-			return nil, ErrUnknownError
-		}
-
-		return nil, errBody.Error
-	}
-
-	if res.StatusCode() != http.StatusOK {
-		errBody, err := models.NewErrorBody(res.Body())
-		if err != nil {
-			return nil, ErrUnknownError
-		}
-
-		return nil, errBody.Error
+		return nil, err
 	}
 
 	resBody := models.UserBody{}
@@ -20,6 +20,22 @@ const (
 	queryViewForWorkbookParams  = `%s?pageSize=%d&pageNumber=%d`
 	queryWorkbooksForSiteParams = `%s?pageSize=%d&pageNumber=%d`
 	queryWorkbooksForUserParams = `%s?pageSize=%d&pageNumber=%d`
+	queryProjectsParams         = `%s?pageSize=%d&pageNumber=%d`
+	queryDataSourcesParams      = `%s?pageSize=%d&pageNumber=%d`
+	downloadDataSourceParams    = `%s?includeExtract=%t`
+	publishWorkbookParams       = `%s?workbookType=%s%s`
+	uploadSessionIDParams       = `&uploadSessionId=%s`
+	downloadWorkbookParams      = `%s?includeExtract=%t`
+	downloadRevisionParams      = `%s?includeExtract=%t`
+	queryRevisionsParams        = `%s?pageSize=%d&pageNumber=%d`
+	asJobParams                 = `&asJob=true`
+	queryJobsParams             = `%s?pageSize=%d&pageNumber=%d`
+	querySchedulesParams        = `%s?pageSize=%d&pageNumber=%d`
+	querySubscriptionsParams    = `%s?pageSize=%d&pageNumber=%d`
+	getFavoritesParams          = `%s?pageSize=%d&pageNumber=%d`
+	querySitesParams            = `%s?pageSize=%d&pageNumber=%d`
+	querySiteByKeyParams        = `?key=%s`
+	siteUsageParams             = `?includeUsageStatistics=true`
 	signInUri                   = `auth/signin`
 	signOutUri                  = `auth/signout`
 	switchSiteUri               = `auth/switchSite`
@@ -50,18 +66,148 @@ const (
 	queryWorkbookUri            = `sites/%s/workbooks/%s`
 	queryWorkbooksForSiteUri    = `sites/%s/workbooks`
 	queryWorkbooksForUserUri    = `sites/%s/users/%s/workbooks`
+	createProjectUri            = `sites/%s/projects`
+	deleteProjectUri            = `sites/%s/projects/%s`
+	queryProjectsUri            = `sites/%s/projects`
+	updateProjectUri            = `sites/%s/projects/%s`
+	projectPermissionsUri       = `sites/%s/projects/%s/permissions`
+	defaultPermissionsUri       = `sites/%s/projects/%s/default-permissions/%s`
+	workbookPermissionsUri      = `sites/%s/workbooks/%s/permissions`
+	viewPermissionsUri          = `sites/%s/views/%s/permissions`
+	dataSourcePermissionsUri    = `sites/%s/datasources/%s/permissions`
+	deletePermissionUri         = `%s/%s/%s/%s/%s`
+	addTagsToDataSourceUri      = `sites/%s/datasources/%s/tags`
+	deleteDataSourceUri         = `sites/%s/datasources/%s`
+	deleteTagFromDataSourceUri  = `sites/%s/datasources/%s/tags/%s`
+	downloadDataSourceUri       = `sites/%s/datasources/%s/content`
+	queryDataSourceUri          = `sites/%s/datasources/%s`
+	queryDataSourcesUri         = `sites/%s/datasources`
+	updateDataSourceUri         = `sites/%s/datasources/%s`
+	publishWorkbookUri          = `sites/%s/workbooks`
+	initiateFileUploadUri       = `sites/%s/fileUploads`
+	appendToFileUploadUri       = `sites/%s/fileUploads/%s`
+	downloadWorkbookUri         = `sites/%s/workbooks/%s/content`
+	downloadWorkbookRevisionUri = `sites/%s/workbooks/%s/revisions/%s/content`
+	queryWorkbookRevisionsUri   = `sites/%s/workbooks/%s/revisions`
+	removeWorkbookRevisionUri   = `sites/%s/workbooks/%s/revisions/%s`
+	deleteWorkbookUri           = `sites/%s/workbooks/%s`
+	updateWorkbookUri           = `sites/%s/workbooks/%s`
+
+	queryWorkbookConnectionsUri   = `sites/%s/workbooks/%s/connections`
+	updateWorkbookConnectionUri   = `sites/%s/workbooks/%s/connections/%s`
+	queryDataSourceConnectionsUri = `sites/%s/datasources/%s/connections`
+	updateDataSourceConnectionUri = `sites/%s/datasources/%s/connections/%s`
+
+	updateWorkbookNowUri   = `sites/%s/workbooks/%s/refresh`
+	updateDataSourceNowUri = `sites/%s/datasources/%s/refresh`
+	cancelJobUri           = `sites/%s/jobs/%s`
+	queryJobUri            = `sites/%s/jobs/%s`
+	queryJobsUri           = `sites/%s/jobs`
+
+	createScheduleUri           = `schedules`
+	deleteScheduleUri           = `schedules/%s`
+	querySchedulesUri           = `schedules`
+	updateScheduleUri           = `schedules/%s`
+	addDataSourceToScheduleUri  = `sites/%s/schedules/%s/datasources`
+	addWorkbookToScheduleUri    = `sites/%s/schedules/%s/workbooks`
+	deleteExtractRefreshTaskUri = `sites/%s/tasks/extractRefreshes/%s`
+	getExtractRefreshTaskUri    = `sites/%s/tasks/extractRefreshes/%s`
+	listExtractRefreshTasksUri  = `sites/%s/tasks/extractRefreshes`
+	runExtractRefreshTaskUri    = `sites/%s/tasks/extractRefreshes/%s/runNow`
+
+	createSubscriptionUri = `sites/%s/subscriptions`
+	deleteSubscriptionUri = `sites/%s/subscriptions/%s`
+	querySubscriptionUri  = `sites/%s/subscriptions/%s`
+	querySubscriptionsUri = `sites/%s/subscriptions`
+	updateSubscriptionUri = `sites/%s/subscriptions/%s`
+
+	addFavoriteUri       = `sites/%s/favorites/%s`
+	deleteFavoriteUri    = `sites/%s/favorites/%s/%s/%s`
+	getFavoritesUri      = `sites/%s/favorites/%s`
+	organizeFavoritesUri = `sites/%s/orderFavorites/%s`
+
+	createSiteUri = `sites`
+	deleteSiteUri = `sites/%s`
+	querySiteUri  = `sites/%s`
+	querySitesUri = `sites`
+	updateSiteUri = `sites/%s`
+
+	serverInfoUri = `serverinfo`
+
+	metadataGraphQLUri     = `api/metadata/graphql`
+	metadataCursorVariable = `after`
+
+	sheetUpstreamFragment = `
+fragment sheetUpstream on Sheet {
+	id luid name
+	upstreamColumns { id name table { __typename id name } }
+	upstreamTables { id luid name database { id luid name } }
+	upstreamDatabases { id luid name }
+}`
+	workbookUpstreamQuery = `query($luid: String!) {
+	workbooks(filter: {luid: $luid}) {
+		id luid name
+		sheets { ...sheetUpstream }
+	}
+}` + sheetUpstreamFragment
+	viewUpstreamQuery = `query($luid: String!) {
+	sheets(filter: {luid: $luid}) { ...sheetUpstream }
+	dashboards(filter: {luid: $luid}) {
+		id luid name
+		sheets { ...sheetUpstream }
+	}
+}` + sheetUpstreamFragment
+	tableDownstreamQuery = `query($name: String!) {
+	databaseTables(filter: {name: $name}) {
+		id luid name
+		database { id luid name }
+		downstreamSheets { id luid name workbook { id } }
+		downstreamWorkbooks { id luid name owner { id luid name } }
+	}
+}`
+
+	siteKeyName       = `name`
+	siteKeyContentUrl = `contentUrl`
 
 	tokenLifetime = 120 * time.Minute
+	maxJWTExpiry  = 10 * time.Minute
 	pageSize      = 500
 	defaultMaxAge = 60
 
-	contentTypeHeader   = `Content-Type`
-	acceptHeader        = `Accept`
-	mimeTypeJSON        = `application/json`
-	mimeTypeImage       = `image/*`
-	mimeTypeAny         = `*/*`
-	authorizationHeader = `Authorization`
-	bearerAuthorization = `Bearer %v`
+	defaultMaxAttempts = 3
+	defaultMinBackoff  = 500 * time.Millisecond
+	defaultMaxBackoff  = 30 * time.Second
+
+	maxPublishFileSize  = 64 << 20
+	fileUploadChunkSize = 5 << 20
+
+	defaultJobPollInterval = 5 * time.Second
+
+	defaultFanOutConcurrency = 4
+
+	minVersionServerInfo          = "2.4"
+	minVersionMetadata            = "3.5"
+	minVersionPersonalAccessToken = "3.6"
+	minVersionOrganizeFavorites   = "3.8"
+	minVersionConnectedApp        = "3.14"
+	minVersionDataAcceleration    = "3.16"
+
+	contentTypeHeader        = `Content-Type`
+	acceptHeader             = `Accept`
+	mimeTypeJSON             = `application/json`
+	mimeTypeImage            = `image/*`
+	mimeTypeAny              = `*/*`
+	mimeTypeOctetStream      = `application/octet-stream`
+	mimeTypeMultipartMixed   = `multipart/mixed; boundary=%s`
+	authorizationHeader      = `Authorization`
+	retryAfterHeader         = `Retry-After`
+	contentDispositionHeader = `Content-Disposition`
+	bearerAuthorization      = `Bearer %v`
+
+	jwtAlgorithm    = `HS256`
+	jwtType         = `JWT`
+	jwtAudience     = `tableau`
+	defaultJWTScope = `tableau:content:read`
 )
 
 var (
@@ -72,8 +218,14 @@ var (
 
 	ErrInvalidHost                 = errors.New("not a valid host")
 	ErrInvalidUsernamePassword     = errors.New("not a valid username or password")
+	ErrInvalidPersonalAccessToken  = errors.New("not a valid personal access token name or secret")
+	ErrInvalidConnectedApp         = errors.New("not a valid connected app client id, secret id, secret value or username")
+	ErrConnectedAppNotConfigured   = errors.New("connected app was not configured")
+	ErrInvalidWorkbookFile         = errors.New("not a valid workbook file, expected .twb or .twbx file")
 	ErrFailedUnmarshalResponseBody = errors.New("failed to unmarshal response body")
 	ErrUnknownError                = errors.New("unknown error")
+	ErrJobFailed                   = errors.New("job failed")
+	ErrJobCancelled                = errors.New("job was cancelled")
 
 	ErrBadRequest             = errors.New("the content of the request body is missing or incomplete")
 	ErrInvalidPageNumber      = errors.New("invalid page number")
@@ -106,7 +258,11 @@ var (
 
 	ErrSiteNotFound                 = errors.New("site was not found")
 	ErrVersionNotFound              = errors.New("invalid version were provided")
+	ErrVersionNotSupported          = errors.New("not supported by the REST API version")
+	ErrGraphQL                      = errors.New("metadata api returned errors")
 	ErrUserNotFound                 = errors.New("user was not found")
+	ErrProjectNotFound              = errors.New("project was not found")
+	ErrDataSourceNotFound           = errors.New("data source was not found")
 	ErrWorkbookNotFound             = errors.New("workbook was not found")
 	ErrTagNotFound                  = errors.New("tag was not found")
 	ErrWorkbookIDMismatch           = errors.New("workbook id mismatch")
@@ -120,6 +276,7 @@ var (
 	ErrUserAlreadyOnSite      = errors.New("the specified user already exist on the site")
 	ErrUserAssetConflict      = errors.New("user still owns content and cannot be deleted")
 	ErrGuestUserNotAllowed    = errors.New("adding user to a site with guest role was not allowed")
+	ErrProjectNameConflict    = errors.New("project name already exists in the parent project")
 	ErrGroupNameAlreadyExists = errors.New("group name already exists")
 	ErrUserAlreadyInGroup     = errors.New("the specified user already a member of the group")
 
@@ -164,6 +321,8 @@ var (
 		"404000": ErrSiteNotFound,
 		"404001": ErrVersionNotFound,
 		"404002": ErrUserNotFound,
+		"404004": ErrDataSourceNotFound,
+		"404005": ErrProjectNotFound,
 		"404006": ErrWorkbookNotFound,
 		"404007": ErrTagNotFound,
 		"404009": ErrWorkbookIDMismatch,
@@ -177,6 +336,7 @@ var (
 		"409000": ErrUserAlreadyOnSite,
 		"409003": ErrUserAssetConflict,
 		"409005": ErrGuestUserNotAllowed,
+		"409006": ErrProjectNameConflict,
 		"409009": ErrGroupNameAlreadyExists,
 		"409011": ErrUserAlreadyInGroup,
 
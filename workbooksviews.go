@@ -1,10 +1,17 @@
 package tableau
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
+	"github.com/go-resty/resty/v2"
 	"github.com/tiketdatarisal/tableau/models"
+	"io"
 	"net/http"
 	. "net/url"
+	"path/filepath"
+	"strings"
 )
 
 type workbooksViews struct {
@@ -19,8 +26,13 @@ type workbooksViews struct {
 //
 // Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_workbooks_and_views.htm#add_tags_to_view
 func (w *workbooksViews) AddTagsToView(viewID string, tagNames []string) ([]models.Tag, error) {
+	return w.AddTagsToViewContext(context.Background(), viewID, tagNames)
+}
+
+// AddTagsToViewContext Same as AddTagsToView, using ctx to cancel the request.
+func (w *workbooksViews) AddTagsToViewContext(ctx context.Context, viewID string, tagNames []string) ([]models.Tag, error) {
 	if !w.base.Authentication.IsSignedIn() {
-		if err := w.base.Authentication.SignIn(); err != nil {
+		if err := w.base.Authentication.SignInContext(ctx); err != nil {
 			return nil, err
 		}
 	}
@@ -42,35 +54,15 @@ func (w *workbooksViews) AddTagsToView(viewID string, tagNames []string) ([]mode
 		})(&struct{ Tag []models.Tag }{Tag: tags}),
 	}
 
-	url := w.base.cfg.GetUrl(fmt.Sprintf(addTagsToViewUri, w.base.Authentication.siteID, viewID))
+	url := w.base.cfg.GetUrl(fmt.Sprintf(addTagsToViewUri, w.base.Authentication.getSiteID(), viewID))
 	if url == "" {
 		return nil, ErrInvalidHost
 	}
 
-	res, err := w.base.c.R().
-		SetHeader(contentTypeHeader, mimeTypeJSON).
-		SetHeader(acceptHeader, mimeTypeJSON).
-		SetHeader(authorizationHeader, w.base.Authentication.getBearerToken()).
-		SetBody(reqBody).
-		Put(url)
-
-	w.base.SetResponse(*res)
+	req := w.base.newRequest(ctx, mimeTypeJSON).SetBody(reqBody)
+	res, err := w.base.execute(req, http.MethodPut, url, http.StatusOK)
 	if err != nil {
-		errBody, err := models.NewErrorBody(res.Body())
-		if err != nil {
-			return nil, ErrUnknownError
-		}
-
-		return nil, errBody.Error
-	}
-
-	if res.StatusCode() != http.StatusOK {
-		errBody, err := models.NewErrorBody(res.Body())
-		if err != nil {
-			return nil, ErrUnknownError
-		}
-
-		return nil, errBody.Error
+		return nil, err
 	}
 
 	resBody := models.TagBody{}
@@ -93,8 +85,13 @@ func (w *workbooksViews) AddTagsToView(viewID string, tagNames []string) ([]mode
 //
 // Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_workbooks_and_views.htm#add_tags_to_workbook
 func (w *workbooksViews) AddTagsToWorkbook(workbookID string, tagNames []string) ([]models.Tag, error) {
+	return w.AddTagsToWorkbookContext(context.Background(), workbookID, tagNames)
+}
+
+// AddTagsToWorkbookContext Same as AddTagsToWorkbook, using ctx to cancel the request.
+func (w *workbooksViews) AddTagsToWorkbookContext(ctx context.Context, workbookID string, tagNames []string) ([]models.Tag, error) {
 	if !w.base.Authentication.IsSignedIn() {
-		if err := w.base.Authentication.SignIn(); err != nil {
+		if err := w.base.Authentication.SignInContext(ctx); err != nil {
 			return nil, err
 		}
 	}
@@ -116,35 +113,15 @@ func (w *workbooksViews) AddTagsToWorkbook(workbookID string, tagNames []string)
 		})(&struct{ Tag []models.Tag }{Tag: tags}),
 	}
 
-	url := w.base.cfg.GetUrl(fmt.Sprintf(addTagsToWorkbookUri, w.base.Authentication.siteID, workbookID))
+	url := w.base.cfg.GetUrl(fmt.Sprintf(addTagsToWorkbookUri, w.base.Authentication.getSiteID(), workbookID))
 	if url == "" {
 		return nil, ErrInvalidHost
 	}
 
-	res, err := w.base.c.R().
-		SetHeader(contentTypeHeader, mimeTypeJSON).
-		SetHeader(acceptHeader, mimeTypeJSON).
-		SetHeader(authorizationHeader, w.base.Authentication.getBearerToken()).
-		SetBody(reqBody).
-		Put(url)
-
-	w.base.SetResponse(*res)
+	req := w.base.newRequest(ctx, mimeTypeJSON).SetBody(reqBody)
+	res, err := w.base.execute(req, http.MethodPut, url, http.StatusOK)
 	if err != nil {
-		errBody, err := models.NewErrorBody(res.Body())
-		if err != nil {
-			return nil, ErrUnknownError
-		}
-
-		return nil, errBody.Error
-	}
-
-	if res.StatusCode() != http.StatusOK {
-		errBody, err := models.NewErrorBody(res.Body())
-		if err != nil {
-			return nil, ErrUnknownError
-		}
-
-		return nil, errBody.Error
+		return nil, err
 	}
 
 	resBody := models.TagBody{}
@@ -159,6 +136,40 @@ func (w *workbooksViews) AddTagsToWorkbook(workbookID string, tagNames []string)
 	return resBody.Tags.Tag, nil
 }
 
+// AddViewPermissions Adds permissions to the specified view for a user or group.
+// Permissions returned by QueryViewPermissions can be passed as grantees.
+//
+// URI:
+//
+//	PUT /api/api-version/sites/site-id/views/view-id/permissions
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_permissions.htm#add_view_permissions
+func (w *workbooksViews) AddViewPermissions(viewID string, grantees []models.GranteeCapabilities) (*models.Permissions, error) {
+	return w.AddViewPermissionsContext(context.Background(), viewID, grantees)
+}
+
+// AddViewPermissionsContext Same as AddViewPermissions, using ctx to cancel the request.
+func (w *workbooksViews) AddViewPermissionsContext(ctx context.Context, viewID string, grantees []models.GranteeCapabilities) (*models.Permissions, error) {
+	return w.base.addPermissions(ctx, grantees, viewPermissionsUri, viewID)
+}
+
+// AddWorkbookPermissions Adds permissions to the specified workbook for a user or group.
+// Permissions returned by QueryWorkbookPermissions can be passed as grantees.
+//
+// URI:
+//
+//	PUT /api/api-version/sites/site-id/workbooks/workbook-id/permissions
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_permissions.htm#add_workbook_permissions
+func (w *workbooksViews) AddWorkbookPermissions(workbookID string, grantees []models.GranteeCapabilities) (*models.Permissions, error) {
+	return w.AddWorkbookPermissionsContext(context.Background(), workbookID, grantees)
+}
+
+// AddWorkbookPermissionsContext Same as AddWorkbookPermissions, using ctx to cancel the request.
+func (w *workbooksViews) AddWorkbookPermissionsContext(ctx context.Context, workbookID string, grantees []models.GranteeCapabilities) (*models.Permissions, error) {
+	return w.base.addPermissions(ctx, grantees, workbookPermissionsUri, workbookID)
+}
+
 // DeleteTagFromView Deletes a tag from the specified view.
 //
 // URI:
@@ -167,40 +178,25 @@ func (w *workbooksViews) AddTagsToWorkbook(workbookID string, tagNames []string)
 //
 // Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_workbooks_and_views.htm#delete_tag_from_view
 func (w *workbooksViews) DeleteTagFromView(viewID, tagName string) error {
+	return w.DeleteTagFromViewContext(context.Background(), viewID, tagName)
+}
+
+// DeleteTagFromViewContext Same as DeleteTagFromView, using ctx to cancel the request.
+func (w *workbooksViews) DeleteTagFromViewContext(ctx context.Context, viewID, tagName string) error {
 	if !w.base.Authentication.IsSignedIn() {
-		if err := w.base.Authentication.SignIn(); err != nil {
+		if err := w.base.Authentication.SignInContext(ctx); err != nil {
 			return err
 		}
 	}
 
-	url := w.base.cfg.GetUrl(fmt.Sprintf(deleteTagFromViewUri, w.base.Authentication.siteID, viewID, QueryEscape(tagName)))
+	url := w.base.cfg.GetUrl(fmt.Sprintf(deleteTagFromViewUri, w.base.Authentication.getSiteID(), viewID, QueryEscape(tagName)))
 	if url == "" {
 		return ErrInvalidHost
 	}
 
-	res, err := w.base.c.R().
-		SetHeader(contentTypeHeader, mimeTypeJSON).
-		SetHeader(acceptHeader, mimeTypeJSON).
-		SetHeader(authorizationHeader, w.base.Authentication.getBearerToken()).
-		Delete(url)
-
-	w.base.SetResponse(*res)
-	if err != nil {
-		errBody, err := models.NewErrorBody(res.Body())
-		if err != nil {
-			return ErrUnknownError
-		}
-
-		return errBody.Error
-	}
-
-	if res.StatusCode() != http.StatusNoContent {
-		errBody, err := models.NewErrorBody(res.Body())
-		if err != nil {
-			return ErrUnknownError
-		}
-
-		return errBody.Error
+	req := w.base.newRequest(ctx, mimeTypeJSON)
+	if _, err := w.base.execute(req, http.MethodDelete, url, http.StatusNoContent); err != nil {
+		return err
 	}
 
 	return nil
@@ -214,43 +210,132 @@ func (w *workbooksViews) DeleteTagFromView(viewID, tagName string) error {
 //
 // Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_workbooks_and_views.htm#delete_tag_from_workbook
 func (w *workbooksViews) DeleteTagFromWorkbook(workbookID, tagName string) error {
+	return w.DeleteTagFromWorkbookContext(context.Background(), workbookID, tagName)
+}
+
+// DeleteTagFromWorkbookContext Same as DeleteTagFromWorkbook, using ctx to cancel the request.
+func (w *workbooksViews) DeleteTagFromWorkbookContext(ctx context.Context, workbookID, tagName string) error {
 	if !w.base.Authentication.IsSignedIn() {
-		if err := w.base.Authentication.SignIn(); err != nil {
+		if err := w.base.Authentication.SignInContext(ctx); err != nil {
 			return err
 		}
 	}
 
-	url := w.base.cfg.GetUrl(fmt.Sprintf(deleteTagFromWorkbookUri, w.base.Authentication.siteID, workbookID, QueryEscape(tagName)))
+	url := w.base.cfg.GetUrl(fmt.Sprintf(deleteTagFromWorkbookUri, w.base.Authentication.getSiteID(), workbookID, QueryEscape(tagName)))
 	if url == "" {
 		return ErrInvalidHost
 	}
 
-	res, err := w.base.c.R().
-		SetHeader(contentTypeHeader, mimeTypeJSON).
-		SetHeader(acceptHeader, mimeTypeJSON).
-		SetHeader(authorizationHeader, w.base.Authentication.getBearerToken()).
-		Delete(url)
+	req := w.base.newRequest(ctx, mimeTypeJSON)
+	if _, err := w.base.execute(req, http.MethodDelete, url, http.StatusNoContent); err != nil {
+		return err
+	}
 
-	w.base.SetResponse(*res)
-	if err != nil {
-		errBody, err := models.NewErrorBody(res.Body())
-		if err != nil {
-			return ErrUnknownError
+	return nil
+}
+
+// DeleteViewPermissions Deletes every capability of the grantee from the specified view permissions.
+// The grantee must be either a user or a group with its ID.
+//
+// URI:
+//
+//	DELETE /api/api-version/sites/site-id/views/view-id/permissions/groups/group-id/capability-name/capability-mode
+//	DELETE /api/api-version/sites/site-id/views/view-id/permissions/users/user-id/capability-name/capability-mode
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_permissions.htm#delete_view_permission
+func (w *workbooksViews) DeleteViewPermissions(viewID string, grantee models.GranteeCapabilities) error {
+	return w.DeleteViewPermissionsContext(context.Background(), viewID, grantee)
+}
+
+// DeleteViewPermissionsContext Same as DeleteViewPermissions, using ctx to cancel the requests.
+func (w *workbooksViews) DeleteViewPermissionsContext(ctx context.Context, viewID string, grantee models.GranteeCapabilities) error {
+	return w.base.deletePermissions(ctx, grantee, viewPermissionsUri, viewID)
+}
+
+// DeleteWorkbook Deletes a workbook. When a workbook is deleted, all of its assets are also deleted, including associated views, data connections, and so on.
+//
+// URI:
+//
+//	DELETE /api/api-version/sites/site-id/workbooks/workbook-id
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_workbooks_and_views.htm#delete_workbook
+func (w *workbooksViews) DeleteWorkbook(workbookID string) error {
+	return w.DeleteWorkbookContext(context.Background(), workbookID)
+}
+
+// DeleteWorkbookContext Same as DeleteWorkbook, using ctx to cancel the request.
+func (w *workbooksViews) DeleteWorkbookContext(ctx context.Context, workbookID string) error {
+	if !w.base.Authentication.IsSignedIn() {
+		if err := w.base.Authentication.SignInContext(ctx); err != nil {
+			return err
 		}
+	}
+
+	url := w.base.cfg.GetUrl(fmt.Sprintf(deleteWorkbookUri, w.base.Authentication.getSiteID(), workbookID))
+	if url == "" {
+		return ErrInvalidHost
+	}
 
-		return errBody.Error
+	req := w.base.newRequest(ctx, mimeTypeJSON)
+	if _, err := w.base.execute(req, http.MethodDelete, url, http.StatusNoContent); err != nil {
+		return err
 	}
 
-	if res.StatusCode() != http.StatusNoContent {
-		errBody, err := models.NewErrorBody(res.Body())
-		if err != nil {
-			return ErrUnknownError
+	return nil
+}
+
+// DeleteWorkbookPermissions Deletes every capability of the grantee from the specified workbook permissions.
+// The grantee must be either a user or a group with its ID.
+//
+// URI:
+//
+//	DELETE /api/api-version/sites/site-id/workbooks/workbook-id/permissions/groups/group-id/capability-name/capability-mode
+//	DELETE /api/api-version/sites/site-id/workbooks/workbook-id/permissions/users/user-id/capability-name/capability-mode
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_permissions.htm#delete_workbook_permission
+func (w *workbooksViews) DeleteWorkbookPermissions(workbookID string, grantee models.GranteeCapabilities) error {
+	return w.DeleteWorkbookPermissionsContext(context.Background(), workbookID, grantee)
+}
+
+// DeleteWorkbookPermissionsContext Same as DeleteWorkbookPermissions, using ctx to cancel the requests.
+func (w *workbooksViews) DeleteWorkbookPermissionsContext(ctx context.Context, workbookID string, grantee models.GranteeCapabilities) error {
+	return w.base.deletePermissions(ctx, grantee, workbookPermissionsUri, workbookID)
+}
+
+// DownloadWorkbook Downloads the specified workbook in .twb or .twbx format into wr, and returns its file name.
+// The extract is included by default, set includeExtract to false to download the workbook without its extract.
+//
+// URI:
+//
+//	GET /api/api-version/sites/site-id/workbooks/workbook-id/content?includeExtract=extract-value
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_workbooks_and_views.htm#download_workbook
+func (w *workbooksViews) DownloadWorkbook(workbookID string, wr io.Writer, includeExtract ...bool) (string, error) {
+	return w.DownloadWorkbookContext(context.Background(), workbookID, wr, includeExtract...)
+}
+
+// DownloadWorkbookContext Same as DownloadWorkbook, using ctx to cancel the request.
+func (w *workbooksViews) DownloadWorkbookContext(ctx context.Context, workbookID string, wr io.Writer, includeExtract ...bool) (string, error) {
+	if !w.base.Authentication.IsSignedIn() {
+		if err := w.base.Authentication.SignInContext(ctx); err != nil {
+			return "", err
 		}
+	}
 
-		return errBody.Error
+	extract := true
+	if len(includeExtract) > 0 {
+		extract = includeExtract[0]
 	}
 
-	return nil
+	url := w.base.cfg.GetUrl(fmt.Sprintf(downloadWorkbookUri, w.base.Authentication.getSiteID(), workbookID))
+	if url == "" {
+		return "", ErrInvalidHost
+	}
+
+	url = fmt.Sprintf(downloadWorkbookParams, url, extract)
+
+	req := w.base.newRequest(ctx, mimeTypeAny)
+	return w.base.download(req, url, wr)
 }
 
 // DownloadWorkbookPDF Downloads a .pdf containing images of the sheets that the user has permission to view in a workbook.
@@ -267,8 +352,13 @@ func (w *workbooksViews) DeleteTagFromWorkbook(workbookID, tagName string) error
 //
 // Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_workbooks_and_views.htm#download_workbook_pdf
 func (w *workbooksViews) DownloadWorkbookPDF(workbookID string, maxAgeInMinutes ...int) ([]byte, error) {
+	return w.DownloadWorkbookPDFContext(context.Background(), workbookID, maxAgeInMinutes...)
+}
+
+// DownloadWorkbookPDFContext Same as DownloadWorkbookPDF, using ctx to cancel the request.
+func (w *workbooksViews) DownloadWorkbookPDFContext(ctx context.Context, workbookID string, maxAgeInMinutes ...int) ([]byte, error) {
 	if !w.base.Authentication.IsSignedIn() {
-		if err := w.base.Authentication.SignIn(); err != nil {
+		if err := w.base.Authentication.SignInContext(ctx); err != nil {
 			return nil, err
 		}
 	}
@@ -281,39 +371,56 @@ func (w *workbooksViews) DownloadWorkbookPDF(workbookID string, maxAgeInMinutes
 		}
 	}
 
-	url := w.base.cfg.GetUrl(fmt.Sprintf(downloadWorkbookPDFUri, w.base.Authentication.siteID, workbookID))
+	url := w.base.cfg.GetUrl(fmt.Sprintf(downloadWorkbookPDFUri, w.base.Authentication.getSiteID(), workbookID))
 	if url == "" {
 		return nil, ErrInvalidHost
 	}
 
 	url = fmt.Sprintf(downloadPDFParams, url, maxAge)
 
-	res, err := w.base.c.R().
-		SetHeader(contentTypeHeader, mimeTypeJSON).
-		SetHeader(acceptHeader, mimeTypeAny).
-		SetHeader(authorizationHeader, w.base.Authentication.getBearerToken()).
-		Get(url)
-
-	w.base.SetResponse(*res)
+	req := w.base.newRequest(ctx, mimeTypeAny)
+	res, err := w.base.execute(req, http.MethodGet, url, http.StatusOK)
 	if err != nil {
-		errBody, err := models.NewErrorBody(res.Body())
-		if err != nil {
-			return nil, ErrUnknownError
-		}
-
-		return nil, errBody.Error
+		return nil, err
 	}
 
-	if res.StatusCode() != http.StatusOK {
-		errBody, err := models.NewErrorBody(res.Body())
-		if err != nil {
-			return nil, ErrUnknownError
+	return res.Body(), nil
+}
+
+// DownloadWorkbookRevision Downloads a specific revision of the specified workbook in .twb or .twbx format into wr, and returns its file name.
+// The extract is included by default, set includeExtract to false to download the revision without its extract.
+//
+// URI:
+//
+//	GET /api/api-version/sites/site-id/workbooks/workbook-id/revisions/revision-number/content
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_revisions.htm#download_workbook_revision
+func (w *workbooksViews) DownloadWorkbookRevision(workbookID, revisionNumber string, wr io.Writer, includeExtract ...bool) (string, error) {
+	return w.DownloadWorkbookRevisionContext(context.Background(), workbookID, revisionNumber, wr, includeExtract...)
+}
+
+// DownloadWorkbookRevisionContext Same as DownloadWorkbookRevision, using ctx to cancel the request.
+func (w *workbooksViews) DownloadWorkbookRevisionContext(ctx context.Context, workbookID, revisionNumber string, wr io.Writer, includeExtract ...bool) (string, error) {
+	if !w.base.Authentication.IsSignedIn() {
+		if err := w.base.Authentication.SignInContext(ctx); err != nil {
+			return "", err
 		}
+	}
 
-		return nil, errBody.Error
+	extract := true
+	if len(includeExtract) > 0 {
+		extract = includeExtract[0]
 	}
 
-	return res.Body(), nil
+	url := w.base.cfg.GetUrl(fmt.Sprintf(downloadWorkbookRevisionUri, w.base.Authentication.getSiteID(), workbookID, revisionNumber))
+	if url == "" {
+		return "", ErrInvalidHost
+	}
+
+	url = fmt.Sprintf(downloadRevisionParams, url, extract)
+
+	req := w.base.newRequest(ctx, mimeTypeAny)
+	return w.base.download(req, url, wr)
 }
 
 // GetView Gets the details of a specific view.
@@ -324,40 +431,26 @@ func (w *workbooksViews) DownloadWorkbookPDF(workbookID string, maxAgeInMinutes
 //
 // Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_workbooks_and_views.htm#get_view
 func (w *workbooksViews) GetView(viewID string) (*models.View, error) {
+	return w.GetViewContext(context.Background(), viewID)
+}
+
+// GetViewContext Same as GetView, using ctx to cancel the request.
+func (w *workbooksViews) GetViewContext(ctx context.Context, viewID string) (*models.View, error) {
 	if !w.base.Authentication.IsSignedIn() {
-		if err := w.base.Authentication.SignIn(); err != nil {
+		if err := w.base.Authentication.SignInContext(ctx); err != nil {
 			return nil, err
 		}
 	}
 
-	url := w.base.cfg.GetUrl(fmt.Sprintf(getViewUri, w.base.Authentication.siteID, viewID))
+	url := w.base.cfg.GetUrl(fmt.Sprintf(getViewUri, w.base.Authentication.getSiteID(), viewID))
 	if url == "" {
 		return nil, ErrInvalidHost
 	}
 
-	res, err := w.base.c.R().
-		SetHeader(contentTypeHeader, mimeTypeJSON).
-		SetHeader(acceptHeader, mimeTypeJSON).
-		SetHeader(authorizationHeader, w.base.Authentication.getBearerToken()).
-		Get(url)
-
-	w.base.SetResponse(*res)
+	req := w.base.newRequest(ctx, mimeTypeJSON)
+	res, err := w.base.execute(req, http.MethodGet, url, http.StatusOK)
 	if err != nil {
-		errBody, err := models.NewErrorBody(res.Body())
-		if err != nil {
-			return nil, ErrUnknownError
-		}
-
-		return nil, errBody.Error
-	}
-
-	if res.StatusCode() != http.StatusOK {
-		errBody, err := models.NewErrorBody(res.Body())
-		if err != nil {
-			return nil, ErrUnknownError
-		}
-
-		return nil, errBody.Error
+		return nil, err
 	}
 
 	resBody := models.ViewBody{}
@@ -376,61 +469,96 @@ func (w *workbooksViews) GetView(viewID string) (*models.View, error) {
 //
 // Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_workbooks_and_views.htm#get_view_by_path
 func (w *workbooksViews) GetViewByPath(viewName string) ([]models.View, error) {
-	if !w.base.Authentication.IsSignedIn() {
-		if err := w.base.Authentication.SignIn(); err != nil {
-			return nil, err
-		}
-	}
+	return w.GetViewByPathContext(context.Background(), viewName)
+}
+
+// GetViewByPathContext Same as GetViewByPath, using ctx to cancel the requests.
+// No more pages are requested after ctx is done.
+func (w *workbooksViews) GetViewByPathContext(ctx context.Context, viewName string) ([]models.View, error) {
+	return w.GetViewByPathPager(PageOptions{}, viewName).All(ctx)
+}
 
-	pageNum := 1
-	var result []models.View
-	for {
-		url := w.base.cfg.GetUrl(fmt.Sprintf(getViewByPathUri, w.base.Authentication.siteID))
+// GetViewByPathPager Same as GetViewByPath, returning a pager that requests the views page by page.
+func (w *workbooksViews) GetViewByPathPager(opt PageOptions, viewName string) *Pager[models.View] {
+	return newPager(w.base, opt, func(ctx context.Context, size, num int) ([]models.View, *models.Pagination, error) {
+		url := w.base.cfg.GetUrl(fmt.Sprintf(getViewByPathUri, w.base.Authentication.getSiteID()))
 		if url == "" {
-			return nil, ErrInvalidHost
+			return nil, nil, ErrInvalidHost
 		}
 
-		url = fmt.Sprintf(getViewByPathParams, url, pageSize, pageNum, QueryEscape(viewName))
-
-		res, err := w.base.c.R().
-			SetHeader(contentTypeHeader, mimeTypeJSON).
-			SetHeader(acceptHeader, mimeTypeJSON).
-			SetHeader(authorizationHeader, w.base.Authentication.getBearerToken()).
-			Get(url)
-
-		w.base.SetResponse(*res)
+		url = fmt.Sprintf(getViewByPathParams, url, size, num, QueryEscape(viewName))
+		req := w.base.newRequest(ctx, mimeTypeJSON)
+		res, err := w.base.execute(req, http.MethodGet, url, http.StatusOK)
 		if err != nil {
-			errBody, err := models.NewErrorBody(res.Body())
-			if err != nil {
-				return nil, ErrUnknownError
-			}
-
-			return nil, errBody.Error
-		}
-
-		if res.StatusCode() != http.StatusOK {
-			errBody, err := models.NewErrorBody(res.Body())
-			if err != nil {
-				return nil, ErrUnknownError
-			}
-
-			return nil, errBody.Error
+			return nil, nil, err
 		}
 
 		resBody := models.QueryViewBody{}
 		if err = json.Unmarshal(res.Body(), &resBody); err != nil {
-			return nil, ErrFailedUnmarshalResponseBody
+			return nil, nil, ErrFailedUnmarshalResponseBody
 		}
 
-		result = append(result, resBody.Views.View...)
-		if pageNum*pageSize >= resBody.Pagination.GetTotalAvailable() {
-			break
+		if resBody.Views == nil {
+			return nil, resBody.Pagination, nil
 		}
 
-		pageNum++
+		return resBody.Views.View, resBody.Pagination, nil
+	})
+}
+
+// PublishWorkbook Publishes a .twb or .twbx workbook file read from file into the project of the workbook.
+// Set Connections of the workbook to embed connection credentials.
+// Files larger than 64 MB are uploaded in chunks using a file upload session before being published.
+//
+// URI:
+//
+//	POST /api/api-version/sites/site-id/workbooks?overwrite=overwrite-flag&skipConnectionCheck=skip-connection-check
+//	POST /api/api-version/sites/site-id/workbooks?uploadSessionId=upload-session-id&workbookType=workbook-type
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_publishing.htm#publish_workbook
+func (w *workbooksViews) PublishWorkbook(workbook *models.Workbook, fileName string, file io.Reader, option ...models.PublishWorkbookOption) (*models.Workbook, error) {
+	return w.PublishWorkbookContext(context.Background(), workbook, fileName, file, option...)
+}
+
+// PublishWorkbookContext Same as PublishWorkbook, using ctx to cancel the requests.
+func (w *workbooksViews) PublishWorkbookContext(ctx context.Context, workbook *models.Workbook, fileName string, file io.Reader, option ...models.PublishWorkbookOption) (*models.Workbook, error) {
+	res, err := w.publishWorkbook(ctx, workbook, fileName, file, false, option...)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody := models.WorkbookBody{}
+	if err = json.Unmarshal(res.Body(), &resBody); err != nil {
+		return nil, ErrFailedUnmarshalResponseBody
 	}
 
-	return result, nil
+	return resBody.Workbook, nil
+}
+
+// PublishWorkbookAsJob Same as PublishWorkbook, publishing the workbook asynchronously and returning the publish job.
+//
+// URI:
+//
+//	POST /api/api-version/sites/site-id/workbooks?asJob=true
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_publishing.htm#publish_workbook
+func (w *workbooksViews) PublishWorkbookAsJob(workbook *models.Workbook, fileName string, file io.Reader, option ...models.PublishWorkbookOption) (*models.Job, error) {
+	return w.PublishWorkbookAsJobContext(context.Background(), workbook, fileName, file, option...)
+}
+
+// PublishWorkbookAsJobContext Same as PublishWorkbookAsJob, using ctx to cancel the requests.
+func (w *workbooksViews) PublishWorkbookAsJobContext(ctx context.Context, workbook *models.Workbook, fileName string, file io.Reader, option ...models.PublishWorkbookOption) (*models.Job, error) {
+	res, err := w.publishWorkbook(ctx, workbook, fileName, file, true, option...)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody := models.JobBody{}
+	if err = json.Unmarshal(res.Body(), &resBody); err != nil {
+		return nil, ErrFailedUnmarshalResponseBody
+	}
+
+	return resBody.Job, nil
 }
 
 // QueryViewsForSite Returns all the views for the specified site, optionally including usage statistics.
@@ -441,64 +569,45 @@ func (w *workbooksViews) GetViewByPath(viewName string) ([]models.View, error) {
 //
 // Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_workbooks_and_views.htm#query_views_for_site
 func (w *workbooksViews) QueryViewsForSite(f models.Filter) ([]models.View, error) {
-	if !w.base.Authentication.IsSignedIn() {
-		if err := w.base.Authentication.SignIn(); err != nil {
-			return nil, err
-		}
-	}
+	return w.QueryViewsForSiteContext(context.Background(), f)
+}
 
-	pageNum := 1
-	var result []models.View
-	for {
-		url := w.base.cfg.GetUrl(fmt.Sprintf(queryViewsForSiteUri, w.base.Authentication.siteID))
+// QueryViewsForSiteContext Same as QueryViewsForSite, using ctx to cancel the requests.
+// No more pages are requested after ctx is done.
+func (w *workbooksViews) QueryViewsForSiteContext(ctx context.Context, f models.Filter) ([]models.View, error) {
+	return w.QueryViewsForSitePager(PageOptions{}, f).All(ctx)
+}
+
+// QueryViewsForSitePager Same as QueryViewsForSite, returning a pager that requests the views page by page.
+func (w *workbooksViews) QueryViewsForSitePager(opt PageOptions, f models.Filter) *Pager[models.View] {
+	return newPager(w.base, opt, func(ctx context.Context, size, num int) ([]models.View, *models.Pagination, error) {
+		url := w.base.cfg.GetUrl(fmt.Sprintf(queryViewsForSiteUri, w.base.Authentication.getSiteID()))
 		if url == "" {
-			return nil, ErrInvalidHost
+			return nil, nil, ErrInvalidHost
 		}
 
-		url = fmt.Sprintf(queryViewForSiteParams, url, pageSize, pageNum)
+		url = fmt.Sprintf(queryViewForSiteParams, url, size, num)
 		if f != nil {
 			url = url + "&filter=" + f.String()
 		}
 
-		res, err := w.base.c.R().
-			SetHeader(contentTypeHeader, mimeTypeJSON).
-			SetHeader(acceptHeader, mimeTypeJSON).
-			SetHeader(authorizationHeader, w.base.Authentication.getBearerToken()).
-			Get(url)
-
-		w.base.SetResponse(*res)
+		req := w.base.newRequest(ctx, mimeTypeJSON)
+		res, err := w.base.execute(req, http.MethodGet, url, http.StatusOK)
 		if err != nil {
-			errBody, err := models.NewErrorBody(res.Body())
-			if err != nil {
-				return nil, ErrUnknownError
-			}
-
-			return nil, errBody.Error
-		}
-
-		if res.StatusCode() != http.StatusOK {
-			errBody, err := models.NewErrorBody(res.Body())
-			if err != nil {
-				return nil, ErrUnknownError
-			}
-
-			return nil, errBody.Error
+			return nil, nil, err
 		}
 
 		resBody := models.QueryViewBody{}
 		if err = json.Unmarshal(res.Body(), &resBody); err != nil {
-			return nil, ErrFailedUnmarshalResponseBody
+			return nil, nil, ErrFailedUnmarshalResponseBody
 		}
 
-		result = append(result, resBody.Views.View...)
-		if pageNum*pageSize >= resBody.Pagination.GetTotalAvailable() {
-			break
+		if resBody.Views == nil {
+			return nil, resBody.Pagination, nil
 		}
 
-		pageNum++
-	}
-
-	return result, nil
+		return resBody.Views.View, resBody.Pagination, nil
+	})
 }
 
 // QueryViewsForWorkbook Returns all the views for the specified workbook, optionally including usage statistics.
@@ -509,61 +618,41 @@ func (w *workbooksViews) QueryViewsForSite(f models.Filter) ([]models.View, erro
 //
 // Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_workbooks_and_views.htm#query_views_for_workbook
 func (w *workbooksViews) QueryViewsForWorkbook(workbookID string) ([]models.View, error) {
-	if !w.base.Authentication.IsSignedIn() {
-		if err := w.base.Authentication.SignIn(); err != nil {
-			return nil, err
-		}
-	}
+	return w.QueryViewsForWorkbookContext(context.Background(), workbookID)
+}
+
+// QueryViewsForWorkbookContext Same as QueryViewsForWorkbook, using ctx to cancel the requests.
+// No more pages are requested after ctx is done.
+func (w *workbooksViews) QueryViewsForWorkbookContext(ctx context.Context, workbookID string) ([]models.View, error) {
+	return w.QueryViewsForWorkbookPager(PageOptions{}, workbookID).All(ctx)
+}
 
-	pageNum := 1
-	var result []models.View
-	for {
-		url := w.base.cfg.GetUrl(fmt.Sprintf(queryViewsForWorkbookUri, w.base.Authentication.siteID, workbookID))
+// QueryViewsForWorkbookPager Same as QueryViewsForWorkbook, returning a pager that requests the views page by page.
+func (w *workbooksViews) QueryViewsForWorkbookPager(opt PageOptions, workbookID string) *Pager[models.View] {
+	return newPager(w.base, opt, func(ctx context.Context, size, num int) ([]models.View, *models.Pagination, error) {
+		url := w.base.cfg.GetUrl(fmt.Sprintf(queryViewsForWorkbookUri, w.base.Authentication.getSiteID(), workbookID))
 		if url == "" {
-			return nil, ErrInvalidHost
+			return nil, nil, ErrInvalidHost
 		}
 
-		url = fmt.Sprintf(queryViewForWorkbookParams, url, pageSize, pageNum)
-
-		res, err := w.base.c.R().
-			SetHeader(contentTypeHeader, mimeTypeJSON).
-			SetHeader(acceptHeader, mimeTypeJSON).
-			SetHeader(authorizationHeader, w.base.Authentication.getBearerToken()).
-			Get(url)
-
-		w.base.SetResponse(*res)
+		url = fmt.Sprintf(queryViewForWorkbookParams, url, size, num)
+		req := w.base.newRequest(ctx, mimeTypeJSON)
+		res, err := w.base.execute(req, http.MethodGet, url, http.StatusOK)
 		if err != nil {
-			errBody, err := models.NewErrorBody(res.Body())
-			if err != nil {
-				return nil, ErrUnknownError
-			}
-
-			return nil, errBody.Error
-		}
-
-		if res.StatusCode() != http.StatusOK {
-			errBody, err := models.NewErrorBody(res.Body())
-			if err != nil {
-				return nil, ErrUnknownError
-			}
-
-			return nil, errBody.Error
+			return nil, nil, err
 		}
 
 		resBody := models.QueryViewBody{}
 		if err = json.Unmarshal(res.Body(), &resBody); err != nil {
-			return nil, ErrFailedUnmarshalResponseBody
+			return nil, nil, ErrFailedUnmarshalResponseBody
 		}
 
-		result = append(result, resBody.Views.View...)
-		if pageNum*pageSize >= resBody.Pagination.GetTotalAvailable() {
-			break
+		if resBody.Views == nil {
+			return nil, resBody.Pagination, nil
 		}
 
-		pageNum++
-	}
-
-	return result, nil
+		return resBody.Views.View, resBody.Pagination, nil
+	})
 }
 
 // QueryViewImage Returns an image of the specified view.
@@ -577,8 +666,13 @@ func (w *workbooksViews) QueryViewsForWorkbook(workbookID string) ([]models.View
 //
 // Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_workbooks_and_views.htm#query_view_image
 func (w *workbooksViews) QueryViewImage(viewID string, option ...models.QueryViewImageOption) ([]byte, error) {
+	return w.QueryViewImageContext(context.Background(), viewID, option...)
+}
+
+// QueryViewImageContext Same as QueryViewImage, using ctx to cancel the request.
+func (w *workbooksViews) QueryViewImageContext(ctx context.Context, viewID string, option ...models.QueryViewImageOption) ([]byte, error) {
 	if !w.base.Authentication.IsSignedIn() {
-		if err := w.base.Authentication.SignIn(); err != nil {
+		if err := w.base.Authentication.SignInContext(ctx); err != nil {
 			return nil, err
 		}
 	}
@@ -588,42 +682,17 @@ func (w *workbooksViews) QueryViewImage(viewID string, option ...models.QueryVie
 		opt = option[0]
 	}
 
-	url := w.base.cfg.GetUrl(fmt.Sprintf(queryViewImageUri, w.base.Authentication.siteID, viewID))
+	url := w.base.cfg.GetUrl(fmt.Sprintf(queryViewImageUri, w.base.Authentication.getSiteID(), viewID))
 	if url == "" {
 		return nil, ErrInvalidHost
 	}
 
 	url = fmt.Sprintf(queryViewImageParams, url, opt.Encode())
-	fmt.Println(url)
-	res, err := w.base.c.R().
-		SetHeader(contentTypeHeader, mimeTypeJSON).
-		SetHeader(acceptHeader, mimeTypeAny).
-		SetHeader(authorizationHeader, w.base.Authentication.getBearerToken()).
-		Get(url)
-
-	w.base.SetResponse(*res)
-	if err != nil {
-		errBody, err := models.NewErrorBody(res.Body())
-		if err != nil {
-			errBody, err = models.NewErrorBodyXML(res.Body())
-			if err != nil {
-				return nil, ErrUnknownError
-			}
-		}
-
-		return nil, errBody.Error
-	}
-
-	if res.StatusCode() != http.StatusOK {
-		errBody, err := models.NewErrorBody(res.Body())
-		if err != nil {
-			errBody, err = models.NewErrorBodyXML(res.Body())
-			if err != nil {
-				return nil, ErrUnknownError
-			}
-		}
 
-		return nil, errBody.Error
+	req := w.base.newRequest(ctx, mimeTypeAny)
+	res, err := w.base.execute(req, http.MethodGet, url, http.StatusOK)
+	if err != nil {
+		return nil, err
 	}
 
 	return res.Body(), nil
@@ -640,8 +709,13 @@ func (w *workbooksViews) QueryViewImage(viewID string, option ...models.QueryVie
 //
 // Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_workbooks_and_views.htm#query_view_pdf
 func (w *workbooksViews) QueryViewPDF(viewID string, maxAgeInMinutes ...int) ([]byte, error) {
+	return w.QueryViewPDFContext(context.Background(), viewID, maxAgeInMinutes...)
+}
+
+// QueryViewPDFContext Same as QueryViewPDF, using ctx to cancel the request.
+func (w *workbooksViews) QueryViewPDFContext(ctx context.Context, viewID string, maxAgeInMinutes ...int) ([]byte, error) {
 	if !w.base.Authentication.IsSignedIn() {
-		if err := w.base.Authentication.SignIn(); err != nil {
+		if err := w.base.Authentication.SignInContext(ctx); err != nil {
 			return nil, err
 		}
 	}
@@ -654,39 +728,36 @@ func (w *workbooksViews) QueryViewPDF(viewID string, maxAgeInMinutes ...int) ([]
 		}
 	}
 
-	url := w.base.cfg.GetUrl(fmt.Sprintf(queryViewPDFUri, w.base.Authentication.siteID, viewID))
+	url := w.base.cfg.GetUrl(fmt.Sprintf(queryViewPDFUri, w.base.Authentication.getSiteID(), viewID))
 	if url == "" {
 		return nil, ErrInvalidHost
 	}
 
 	url = fmt.Sprintf(queryViewPDFParams, url, maxAge)
 
-	res, err := w.base.c.R().
-		SetHeader(contentTypeHeader, mimeTypeJSON).
-		SetHeader(acceptHeader, mimeTypeAny).
-		SetHeader(authorizationHeader, w.base.Authentication.getBearerToken()).
-		Get(url)
-
-	w.base.SetResponse(*res)
+	req := w.base.newRequest(ctx, mimeTypeAny)
+	res, err := w.base.execute(req, http.MethodGet, url, http.StatusOK)
 	if err != nil {
-		errBody, err := models.NewErrorBody(res.Body())
-		if err != nil {
-			return nil, ErrUnknownError
-		}
-
-		return nil, errBody.Error
+		return nil, err
 	}
 
-	if res.StatusCode() != http.StatusOK {
-		errBody, err := models.NewErrorBody(res.Body())
-		if err != nil {
-			return nil, ErrUnknownError
-		}
+	return res.Body(), nil
+}
 
-		return nil, errBody.Error
-	}
+// QueryViewPermissions Returns a list of permissions for the specified view.
+//
+// URI:
+//
+//	GET /api/api-version/sites/site-id/views/view-id/permissions
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_permissions.htm#query_view_permissions
+func (w *workbooksViews) QueryViewPermissions(viewID string) (*models.Permissions, error) {
+	return w.QueryViewPermissionsContext(context.Background(), viewID)
+}
 
-	return res.Body(), nil
+// QueryViewPermissionsContext Same as QueryViewPermissions, using ctx to cancel the request.
+func (w *workbooksViews) QueryViewPermissionsContext(ctx context.Context, viewID string) (*models.Permissions, error) {
+	return w.base.queryPermissions(ctx, viewPermissionsUri, viewID)
 }
 
 // QueryWorkbook Returns information about the specified workbook, including information about views and tags.
@@ -697,40 +768,26 @@ func (w *workbooksViews) QueryViewPDF(viewID string, maxAgeInMinutes ...int) ([]
 //
 // Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_workbooks_and_views.htm#query_workbook
 func (w *workbooksViews) QueryWorkbook(workbookID string) (*models.Workbook, error) {
+	return w.QueryWorkbookContext(context.Background(), workbookID)
+}
+
+// QueryWorkbookContext Same as QueryWorkbook, using ctx to cancel the request.
+func (w *workbooksViews) QueryWorkbookContext(ctx context.Context, workbookID string) (*models.Workbook, error) {
 	if !w.base.Authentication.IsSignedIn() {
-		if err := w.base.Authentication.SignIn(); err != nil {
+		if err := w.base.Authentication.SignInContext(ctx); err != nil {
 			return nil, err
 		}
 	}
 
-	url := w.base.cfg.GetUrl(fmt.Sprintf(queryWorkbookUri, w.base.Authentication.siteID, workbookID))
+	url := w.base.cfg.GetUrl(fmt.Sprintf(queryWorkbookUri, w.base.Authentication.getSiteID(), workbookID))
 	if url == "" {
 		return nil, ErrInvalidHost
 	}
 
-	res, err := w.base.c.R().
-		SetHeader(contentTypeHeader, mimeTypeJSON).
-		SetHeader(acceptHeader, mimeTypeJSON).
-		SetHeader(authorizationHeader, w.base.Authentication.getBearerToken()).
-		Get(url)
-
-	w.base.SetResponse(*res)
+	req := w.base.newRequest(ctx, mimeTypeJSON)
+	res, err := w.base.execute(req, http.MethodGet, url, http.StatusOK)
 	if err != nil {
-		errBody, err := models.NewErrorBody(res.Body())
-		if err != nil {
-			return nil, ErrUnknownError
-		}
-
-		return nil, errBody.Error
-	}
-
-	if res.StatusCode() != http.StatusOK {
-		errBody, err := models.NewErrorBody(res.Body())
-		if err != nil {
-			return nil, ErrUnknownError
-		}
-
-		return nil, errBody.Error
+		return nil, err
 	}
 
 	resBody := models.WorkbookBody{}
@@ -741,6 +798,85 @@ func (w *workbooksViews) QueryWorkbook(workbookID string) (*models.Workbook, err
 	return resBody.Workbook, nil
 }
 
+// QueryWorkbookConnections Returns a list of data connections for the specified workbook.
+//
+// URI:
+//
+//	GET /api/api-version/sites/site-id/workbooks/workbook-id/connections
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_workbooks_and_views.htm#query_workbook_connections
+func (w *workbooksViews) QueryWorkbookConnections(workbookID string) ([]models.Connection, error) {
+	return w.QueryWorkbookConnectionsContext(context.Background(), workbookID)
+}
+
+// QueryWorkbookConnectionsContext Same as QueryWorkbookConnections, using ctx to cancel the request.
+func (w *workbooksViews) QueryWorkbookConnectionsContext(ctx context.Context, workbookID string) ([]models.Connection, error) {
+	return w.base.queryConnections(ctx, queryWorkbookConnectionsUri, workbookID)
+}
+
+// QueryWorkbookPermissions Returns a list of permissions for the specified workbook.
+//
+// URI:
+//
+//	GET /api/api-version/sites/site-id/workbooks/workbook-id/permissions
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_permissions.htm#query_workbook_permissions
+func (w *workbooksViews) QueryWorkbookPermissions(workbookID string) (*models.Permissions, error) {
+	return w.QueryWorkbookPermissionsContext(context.Background(), workbookID)
+}
+
+// QueryWorkbookPermissionsContext Same as QueryWorkbookPermissions, using ctx to cancel the request.
+func (w *workbooksViews) QueryWorkbookPermissionsContext(ctx context.Context, workbookID string) (*models.Permissions, error) {
+	return w.base.queryPermissions(ctx, workbookPermissionsUri, workbookID)
+}
+
+// QueryWorkbookRevisions Returns a list of revision information (history) for the specified workbook.
+// Revision history must be enabled for the site.
+//
+// URI:
+//
+//	GET /api/api-version/sites/site-id/workbooks/workbook-id/revisions
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_revisions.htm#get_workbook_revisions
+func (w *workbooksViews) QueryWorkbookRevisions(workbookID string) ([]models.Revision, error) {
+	return w.QueryWorkbookRevisionsContext(context.Background(), workbookID)
+}
+
+// QueryWorkbookRevisionsContext Same as QueryWorkbookRevisions, using ctx to cancel the requests.
+// No more pages are requested after ctx is done.
+func (w *workbooksViews) QueryWorkbookRevisionsContext(ctx context.Context, workbookID string) ([]models.Revision, error) {
+	return w.QueryWorkbookRevisionsPager(PageOptions{}, workbookID).All(ctx)
+}
+
+// QueryWorkbookRevisionsPager Same as QueryWorkbookRevisions, returning a pager that requests the revisions page by page.
+func (w *workbooksViews) QueryWorkbookRevisionsPager(opt PageOptions, workbookID string) *Pager[models.Revision] {
+	return newPager(w.base, opt, func(ctx context.Context, size, num int) ([]models.Revision, *models.Pagination, error) {
+		url := w.base.cfg.GetUrl(fmt.Sprintf(queryWorkbookRevisionsUri, w.base.Authentication.getSiteID(), workbookID))
+		if url == "" {
+			return nil, nil, ErrInvalidHost
+		}
+
+		url = fmt.Sprintf(queryRevisionsParams, url, size, num)
+
+		req := w.base.newRequest(ctx, mimeTypeJSON)
+		res, err := w.base.execute(req, http.MethodGet, url, http.StatusOK)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		resBody := models.QueryRevisionBody{}
+		if err = json.Unmarshal(res.Body(), &resBody); err != nil {
+			return nil, nil, ErrFailedUnmarshalResponseBody
+		}
+
+		if resBody.Revisions == nil {
+			return nil, resBody.Pagination, nil
+		}
+
+		return resBody.Revisions.Revision, resBody.Pagination, nil
+	})
+}
+
 // QueryWorkbooksForSite Returns the workbooks on a site.
 // If the user is not an administrator, the method returns just the workbooks that the user has permissions to view.
 //
@@ -750,130 +886,333 @@ func (w *workbooksViews) QueryWorkbook(workbookID string) (*models.Workbook, err
 //
 // Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_workbooks_and_views.htm#query_workbooks_for_site
 func (w *workbooksViews) QueryWorkbooksForSite(f models.Filter) ([]models.Workbook, error) {
-	if !w.base.Authentication.IsSignedIn() {
-		if err := w.base.Authentication.SignIn(); err != nil {
-			return nil, err
-		}
-	}
+	return w.QueryWorkbooksForSiteContext(context.Background(), f)
+}
 
-	pageNum := 1
-	var result []models.Workbook
-	for {
-		url := w.base.cfg.GetUrl(fmt.Sprintf(queryWorkbooksForSiteUri, w.base.Authentication.siteID))
+// QueryWorkbooksForSiteContext Same as QueryWorkbooksForSite, using ctx to cancel the requests.
+// No more pages are requested after ctx is done.
+func (w *workbooksViews) QueryWorkbooksForSiteContext(ctx context.Context, f models.Filter) ([]models.Workbook, error) {
+	return w.QueryWorkbooksForSitePager(PageOptions{}, f).All(ctx)
+}
+
+// QueryWorkbooksForSitePager Same as QueryWorkbooksForSite, returning a pager that requests the workbooks page by page.
+func (w *workbooksViews) QueryWorkbooksForSitePager(opt PageOptions, f models.Filter) *Pager[models.Workbook] {
+	return newPager(w.base, opt, func(ctx context.Context, size, num int) ([]models.Workbook, *models.Pagination, error) {
+		url := w.base.cfg.GetUrl(fmt.Sprintf(queryWorkbooksForSiteUri, w.base.Authentication.getSiteID()))
 		if url == "" {
-			return nil, ErrInvalidHost
+			return nil, nil, ErrInvalidHost
 		}
 
-		url = fmt.Sprintf(queryWorkbooksForSiteParams, url, pageSize, pageNum)
+		url = fmt.Sprintf(queryWorkbooksForSiteParams, url, size, num)
 		if f != nil {
 			url = url + "&filter=" + f.String()
 		}
 
-		res, err := w.base.c.R().
-			SetHeader(contentTypeHeader, mimeTypeJSON).
-			SetHeader(acceptHeader, mimeTypeJSON).
-			SetHeader(authorizationHeader, w.base.Authentication.getBearerToken()).
-			Get(url)
-
-		w.base.SetResponse(*res)
+		req := w.base.newRequest(ctx, mimeTypeJSON)
+		res, err := w.base.execute(req, http.MethodGet, url, http.StatusOK)
 		if err != nil {
-			errBody, err := models.NewErrorBody(res.Body())
-			if err != nil {
-				return nil, ErrUnknownError
-			}
+			return nil, nil, err
+		}
 
-			return nil, errBody.Error
+		resBody := models.QueryWorkbookBody{}
+		if err = json.Unmarshal(res.Body(), &resBody); err != nil {
+			return nil, nil, ErrFailedUnmarshalResponseBody
 		}
 
-		if res.StatusCode() != http.StatusOK {
-			errBody, err := models.NewErrorBody(res.Body())
-			if err != nil {
-				return nil, ErrUnknownError
-			}
+		if resBody.Workbooks == nil {
+			return nil, resBody.Pagination, nil
+		}
 
-			return nil, errBody.Error
+		return resBody.Workbooks.Workbook, resBody.Pagination, nil
+	})
+}
+
+// QueryWorkbooksForUser Returns the workbooks that the specified user owns in addition to those that the user has Read (view) permissions for.
+//
+// URI:
+//
+//	GET /api/api-version/sites/site-id/users/user-id/workbooks
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_workbooks_and_views.htm#query_workbooks_for_user
+func (w *workbooksViews) QueryWorkbooksForUser(ownedByUser ...bool) ([]models.Workbook, error) {
+	return w.QueryWorkbooksForUserContext(context.Background(), ownedByUser...)
+}
+
+// QueryWorkbooksForUserContext Same as QueryWorkbooksForUser, using ctx to cancel the requests.
+// No more pages are requested after ctx is done.
+func (w *workbooksViews) QueryWorkbooksForUserContext(ctx context.Context, ownedByUser ...bool) ([]models.Workbook, error) {
+	return w.QueryWorkbooksForUserPager(PageOptions{}, ownedByUser...).All(ctx)
+}
+
+// QueryWorkbooksForUserPager Same as QueryWorkbooksForUser, returning a pager that requests the workbooks page by page.
+func (w *workbooksViews) QueryWorkbooksForUserPager(opt PageOptions, ownedByUser ...bool) *Pager[models.Workbook] {
+	return newPager(w.base, opt, func(ctx context.Context, size, num int) ([]models.Workbook, *models.Pagination, error) {
+		url := w.base.cfg.GetUrl(fmt.Sprintf(queryWorkbooksForUserUri, w.base.Authentication.getSiteID(), w.base.Authentication.getUserID()))
+		if url == "" {
+			return nil, nil, ErrInvalidHost
+		}
+
+		url = fmt.Sprintf(queryWorkbooksForUserParams, url, size, num)
+		if len(ownedByUser) > 0 {
+			url = url + fmt.Sprintf("&ownedBy=%t", ownedByUser[0])
+		}
+
+		req := w.base.newRequest(ctx, mimeTypeJSON)
+		res, err := w.base.execute(req, http.MethodGet, url, http.StatusOK)
+		if err != nil {
+			return nil, nil, err
 		}
 
 		resBody := models.QueryWorkbookBody{}
 		if err = json.Unmarshal(res.Body(), &resBody); err != nil {
-			return nil, ErrFailedUnmarshalResponseBody
+			return nil, nil, ErrFailedUnmarshalResponseBody
 		}
 
-		result = append(result, resBody.Workbooks.Workbook...)
-		if pageNum*pageSize >= resBody.Pagination.GetTotalAvailable() {
-			break
+		if resBody.Workbooks == nil {
+			return nil, resBody.Pagination, nil
 		}
 
-		pageNum++
+		return resBody.Workbooks.Workbook, resBody.Pagination, nil
+	})
+}
+
+// RemoveWorkbookRevision Removes a specific version of a workbook from the specified site.
+// The current revision of the workbook cannot be removed, publish a previous revision to roll back instead.
+//
+// URI:
+//
+//	DELETE /api/api-version/sites/site-id/workbooks/workbook-id/revisions/revision-number
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_revisions.htm#remove_workbook_revision
+func (w *workbooksViews) RemoveWorkbookRevision(workbookID, revisionNumber string) error {
+	return w.RemoveWorkbookRevisionContext(context.Background(), workbookID, revisionNumber)
+}
+
+// RemoveWorkbookRevisionContext Same as RemoveWorkbookRevision, using ctx to cancel the request.
+func (w *workbooksViews) RemoveWorkbookRevisionContext(ctx context.Context, workbookID, revisionNumber string) error {
+	if !w.base.Authentication.IsSignedIn() {
+		if err := w.base.Authentication.SignInContext(ctx); err != nil {
+			return err
+		}
+	}
+
+	url := w.base.cfg.GetUrl(fmt.Sprintf(removeWorkbookRevisionUri, w.base.Authentication.getSiteID(), workbookID, revisionNumber))
+	if url == "" {
+		return ErrInvalidHost
 	}
 
-	return result, nil
+	req := w.base.newRequest(ctx, mimeTypeJSON)
+	if _, err := w.base.execute(req, http.MethodDelete, url, http.StatusNoContent); err != nil {
+		return err
+	}
+
+	return nil
 }
 
-// QueryWorkbooksForUser Returns the workbooks that the specified user owns in addition to those that the user has Read (view) permissions for.
+// UpdateWorkbookConnection Updates the server address, port, username, or password for the specified workbook connection.
+// Set EmbedPassword to embed the password into the connection.
 //
 // URI:
 //
-//	GET /api/api-version/sites/site-id/users/user-id/workbooks
+//	PUT /api/api-version/sites/site-id/workbooks/workbook-id/connections/connection-id
 //
-// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_workbooks_and_views.htm#query_workbooks_for_user
-func (w *workbooksViews) QueryWorkbooksForUser(ownedByUser ...bool) ([]models.Workbook, error) {
-	if !w.base.Authentication.IsSignedIn() {
-		if err := w.base.Authentication.SignIn(); err != nil {
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_workbooks_and_views.htm#update_workbook_connection
+func (w *workbooksViews) UpdateWorkbookConnection(workbookID string, connection *models.Connection) (*models.Connection, error) {
+	return w.UpdateWorkbookConnectionContext(context.Background(), workbookID, connection)
+}
+
+// UpdateWorkbookConnectionContext Same as UpdateWorkbookConnection, using ctx to cancel the request.
+func (w *workbooksViews) UpdateWorkbookConnectionContext(ctx context.Context, workbookID string, connection *models.Connection) (*models.Connection, error) {
+	if connection == nil || connection.ID == nil {
+		return nil, ErrBadRequest
+	}
+
+	return w.base.updateConnection(ctx, connection, updateWorkbookConnectionUri, workbookID, *connection.ID)
+}
+
+// UpdateWorkbook Modifies an existing workbook, allowing you to change the name, description, owner, or project that the workbook belongs to,
+// and whether the workbook shows views in tabs, its extracts are encrypted, or data acceleration is enabled.
+// To move the workbook, set Project with the ID of the target project.
+// Data acceleration requires REST API version 3.16 or later.
+//
+// URI:
+//
+//	PUT /api/api-version/sites/site-id/workbooks/workbook-id
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_workbooks_and_views.htm#update_workbook
+func (w *workbooksViews) UpdateWorkbook(workbook *models.Workbook) (*models.Workbook, error) {
+	return w.UpdateWorkbookContext(context.Background(), workbook)
+}
+
+// UpdateWorkbookContext Same as UpdateWorkbook, using ctx to cancel the request.
+func (w *workbooksViews) UpdateWorkbookContext(ctx context.Context, workbook *models.Workbook) (*models.Workbook, error) {
+	if workbook != nil && workbook.DataAccelerationConfig != nil {
+		if err := w.base.requireVersion("UpdateWorkbook with data acceleration", minVersionDataAcceleration); err != nil {
 			return nil, err
 		}
 	}
 
-	pageNum := 1
-	var result []models.Workbook
-	for {
-		url := w.base.cfg.GetUrl(fmt.Sprintf(queryWorkbooksForUserUri, w.base.Authentication.siteID, w.base.Authentication.userID))
-		if url == "" {
-			return nil, ErrInvalidHost
+	if !w.base.Authentication.IsSignedIn() {
+		if err := w.base.Authentication.SignInContext(ctx); err != nil {
+			return nil, err
 		}
+	}
 
-		url = fmt.Sprintf(queryWorkbooksForUserParams, url, pageSize, pageNum)
-		if len(ownedByUser) > 0 {
-			url = url + fmt.Sprintf("&ownedBy=%t", ownedByUser[0])
-		}
+	if workbook == nil || workbook.ID == nil {
+		return nil, ErrBadRequest
+	}
 
-		res, err := w.base.c.R().
-			SetHeader(contentTypeHeader, mimeTypeJSON).
-			SetHeader(acceptHeader, mimeTypeJSON).
-			SetHeader(authorizationHeader, w.base.Authentication.getBearerToken()).
-			Get(url)
+	reqBody := models.WorkbookBody{
+		Workbook: &models.Workbook{
+			Name:            workbook.Name,
+			Description:     workbook.Description,
+			ShowTabs:        workbook.ShowTabs,
+			EncryptExtracts: workbook.EncryptExtracts,
+			Owner:           workbook.Owner,
+		},
+	}
 
-		w.base.SetResponse(*res)
-		if err != nil {
-			errBody, err := models.NewErrorBody(res.Body())
-			if err != nil {
-				return nil, ErrUnknownError
-			}
+	if workbook.Project != nil {
+		reqBody.Workbook.Project = &models.Project{ID: workbook.Project.ID}
+	}
 
-			return nil, errBody.Error
+	if workbook.DataAccelerationConfig != nil {
+		reqBody.Workbook.DataAccelerationConfig = &models.DataAccelerationConfig{
+			AccelerationEnabled: workbook.DataAccelerationConfig.AccelerationEnabled,
 		}
+	}
 
-		if res.StatusCode() != http.StatusOK {
-			errBody, err := models.NewErrorBody(res.Body())
-			if err != nil {
-				return nil, ErrUnknownError
-			}
+	url := w.base.cfg.GetUrl(fmt.Sprintf(updateWorkbookUri, w.base.Authentication.getSiteID(), *workbook.ID))
+	if url == "" {
+		return nil, ErrInvalidHost
+	}
+
+	req := retryIdempotent(w.base.newRequest(ctx, mimeTypeJSON).SetBody(reqBody))
+	res, err := w.base.execute(req, http.MethodPut, url, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody := models.WorkbookBody{}
+	if err = json.Unmarshal(res.Body(), &resBody); err != nil {
+		return nil, ErrFailedUnmarshalResponseBody
+	}
+
+	return resBody.Workbook, nil
+}
 
-			return nil, errBody.Error
+// UpdateWorkbookNow Runs an extract refresh on the specified workbook, and returns the extract refresh job.
+// Use Jobs.WaitForJob to wait until the refresh is completed.
+//
+// URI:
+//
+//	POST /api/api-version/sites/site-id/workbooks/workbook-id/refresh
+//
+// Reference: https://help.tableau.com/current/api/rest_api/en-us/REST/rest_api_ref_workbooks_and_views.htm#update_workbook_now
+func (w *workbooksViews) UpdateWorkbookNow(workbookID string) (*models.Job, error) {
+	return w.UpdateWorkbookNowContext(context.Background(), workbookID)
+}
+
+// UpdateWorkbookNowContext Same as UpdateWorkbookNow, using ctx to cancel the request.
+func (w *workbooksViews) UpdateWorkbookNowContext(ctx context.Context, workbookID string) (*models.Job, error) {
+	if !w.base.Authentication.IsSignedIn() {
+		if err := w.base.Authentication.SignInContext(ctx); err != nil {
+			return nil, err
 		}
+	}
 
-		resBody := models.QueryWorkbookBody{}
-		if err = json.Unmarshal(res.Body(), &resBody); err != nil {
-			return nil, ErrFailedUnmarshalResponseBody
+	url := w.base.cfg.GetUrl(fmt.Sprintf(updateWorkbookNowUri, w.base.Authentication.getSiteID(), workbookID))
+	if url == "" {
+		return nil, ErrInvalidHost
+	}
+
+	req := w.base.newRequest(ctx, mimeTypeJSON).SetBody(struct{}{})
+	res, err := w.base.execute(req, http.MethodPost, url, http.StatusAccepted)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody := models.JobBody{}
+	if err = json.Unmarshal(res.Body(), &resBody); err != nil {
+		return nil, ErrFailedUnmarshalResponseBody
+	}
+
+	return resBody.Job, nil
+}
+
+// publishWorkbook publishes workbook file in a single request, or commits a file upload session for files larger than 64 MB.
+func (w *workbooksViews) publishWorkbook(ctx context.Context, workbook *models.Workbook, fileName string, file io.Reader, asJob bool, option ...models.PublishWorkbookOption) (*resty.Response, error) {
+	if !w.base.Authentication.IsSignedIn() {
+		if err := w.base.Authentication.SignInContext(ctx); err != nil {
+			return nil, err
 		}
+	}
 
-		result = append(result, resBody.Workbooks.Workbook...)
-		if pageNum*pageSize >= resBody.Pagination.GetTotalAvailable() {
-			break
+	if workbook == nil || workbook.Name == nil || workbook.Project == nil || workbook.Project.ID == nil || file == nil {
+		return nil, ErrBadRequest
+	}
+
+	workbookType := strings.ToLower(strings.TrimPrefix(filepath.Ext(fileName), "."))
+	if workbookType != "twb" && workbookType != "twbx" {
+		return nil, ErrInvalidWorkbookFile
+	}
+
+	opt := models.PublishWorkbookOption{}
+	if len(option) > 0 {
+		opt = option[0]
+	}
+
+	reqBody := models.WorkbookBody{
+		Workbook: &models.Workbook{
+			Name:        workbook.Name,
+			Description: workbook.Description,
+			ShowTabs:    workbook.ShowTabs,
+			Project:     &models.Project{ID: workbook.Project.ID},
+			Connections: workbook.Connections,
+		},
+	}
+
+	url := w.base.cfg.GetUrl(fmt.Sprintf(publishWorkbookUri, w.base.Authentication.getSiteID()))
+	if url == "" {
+		return nil, ErrInvalidHost
+	}
+
+	url = fmt.Sprintf(publishWorkbookParams, url, workbookType, opt.Encode())
+	if asJob {
+		url = url + asJobParams
+	}
+
+	buf := &bytes.Buffer{}
+	n, err := io.CopyN(buf, file, maxPublishFileSize+1)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	var part *filePart
+	if n <= maxPublishFileSize {
+		part = &filePart{field: "tableau_workbook", name: filepath.Base(fileName), data: buf.Bytes()}
+	} else {
+		sessionID, err := w.base.uploadFile(ctx, io.MultiReader(buf, file))
+		if err != nil {
+			return nil, err
 		}
 
-		pageNum++
+		url = url + fmt.Sprintf(uploadSessionIDParams, sessionID)
 	}
 
-	return result, nil
+	body, contentType, err := newMultipartMixed(reqBody, part)
+	if err != nil {
+		return nil, err
+	}
+
+	status := http.StatusCreated
+	if asJob {
+		status = http.StatusAccepted
+	}
+
+	req := w.base.newRequest(ctx, mimeTypeJSON).
+		SetHeader(contentTypeHeader, contentType).
+		SetBody(body)
+
+	return w.base.execute(req, http.MethodPost, url, status)
 }